@@ -0,0 +1,269 @@
+package commands
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hazelcast/hazelcast-go-client"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/internal"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/mapping"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+// the resolved auto types are kept in this property during the execution of a command
+const propAutoTypes = "auto-types"
+
+// autoTypes keeps the resolved key and value types, so they are resolved once for each execution of a command.
+type autoTypes struct {
+	mu    sync.Mutex
+	types map[bool]string
+}
+
+// AutoTypeAugmentor sets the resolved auto types for the execution of a command.
+type AutoTypeAugmentor struct{}
+
+func (AutoTypeAugmentor) Augment(ec plug.ExecContext, props *plug.Properties) error {
+	props.Set(propAutoTypes, &autoTypes{types: map[bool]string{}})
+	return nil
+}
+
+// resolveTypeName returns the type name to convert the key or the value to.
+// If the type name is auto and the command works on a Map, the type is resolved using the SQL mapping of the Map,
+// or an entry sampled from it.
+// Otherwise, the type name is returned as is, and the type is inferred from the value during the conversion.
+// The type is resolved once for each execution of the command.
+func resolveTypeName(ctx context.Context, ec plug.ExecContext, ci *hazelcast.ClientInternal, typeName string, key bool) string {
+	if !strings.EqualFold(typeName, internal.TypeNameAuto) || !isMapCommand(ec.CommandName()) {
+		return typeName
+	}
+	v, ok := ec.Props().Get(propAutoTypes)
+	if !ok {
+		return autoTypeNameOr(ctx, ec, ci, typeName, key)
+	}
+	ats := v.(*autoTypes)
+	ats.mu.Lock()
+	defer ats.mu.Unlock()
+	tn, ok := ats.types[key]
+	if !ok {
+		tn = autoTypeNameOr(ctx, ec, ci, typeName, key)
+		ats.types[key] = tn
+	}
+	return tn
+}
+
+func autoTypeNameOr(ctx context.Context, ec plug.ExecContext, ci *hazelcast.ClientInternal, typeName string, key bool) string {
+	if tn := autoTypeName(ctx, ec, ci, key); tn != "" {
+		return tn
+	}
+	return typeName
+}
+
+// autoTypeName returns the type name resolved using the SQL mapping of the Map, or an entry sampled from it.
+// Returns an empty string if the type could not be resolved.
+func autoTypeName(ctx context.Context, ec plug.ExecContext, ci *hazelcast.ClientInternal, key bool) string {
+	name := ec.Props().GetString(base.FlagName)
+	what := "value"
+	if key {
+		what = "key"
+	}
+	tn, err := mappingTypeName(ctx, ci, name, key)
+	if err != nil {
+		ec.Logger().Debugf("Could not resolve the %s type from the SQL mapping of %s: %s", what, name, err.Error())
+	}
+	if tn != "" {
+		ec.Logger().Debugf("Resolved the %s type from the SQL mapping of %s: %s", what, name, tn)
+		return tn
+	}
+	tn, err = sampledTypeName(ctx, ci, name, key)
+	if err != nil {
+		ec.Logger().Debugf("Could not resolve the %s type from the entries of %s: %s", what, name, err.Error())
+	}
+	if tn != "" {
+		ec.Logger().Debugf("Resolved the %s type from the entries of %s: %s", what, name, tn)
+	}
+	return tn
+}
+
+// isMapCommand returns true if the given command path is of a Map command.
+func isMapCommand(path string) bool {
+	// the command path is in the "clc map set" format, or the "\map set" format in the interactive mode
+	parts := strings.Fields(path)
+	return len(parts) >= 2 && strings.TrimPrefix(parts[len(parts)-2], `\`) == "map"
+}
+
+// mappingTypeName returns the type name for the key or the value format of the SQL mapping of the given Map.
+// Returns an empty string if there is no mapping, or the format does not correspond to a supported type.
+func mappingTypeName(ctx context.Context, ci *hazelcast.ClientInternal, mapName string, key bool) (string, error) {
+	q := "SELECT mapping_options FROM information_schema.mappings WHERE mapping_type = ? AND mapping_external_name = ?"
+	res, err := ci.Client().SQL().Execute(ctx, q, mapping.TypeIMap, mapName)
+	if err != nil {
+		return "", err
+	}
+	defer res.Close()
+	it, err := res.Iterator()
+	if err != nil {
+		return "", err
+	}
+	if !it.HasNext() {
+		return "", nil
+	}
+	row, err := it.Next()
+	if err != nil {
+		return "", err
+	}
+	opts, err := row.Get(0)
+	if err != nil {
+		return "", err
+	}
+	var options map[string]string
+	if err := json.Unmarshal([]byte(fmt.Sprint(opts)), &options); err != nil {
+		return "", fmt.Errorf("decoding mapping options: %w", err)
+	}
+	prefix := mapping.OptionValuePrefix
+	if key {
+		prefix = mapping.OptionKeyPrefix
+	}
+	return sqlFormatTypeName(options, prefix), nil
+}
+
+// sqlFormatTypeName returns the type name for the key or the value format in the SQL mapping options.
+func sqlFormatTypeName(options map[string]string, prefix string) string {
+	format := strings.ToLower(options[prefix+mapping.OptionFormat])
+	switch format {
+	case mapping.FormatJava:
+		return javaClassTypeNames[options[prefix+mapping.OptionJavaClass]]
+	case mapping.FormatJSON:
+		return internal.TypeNameJSON
+	case mapping.FormatCompact:
+		if tn := options[prefix+mapping.OptionCompactTypeName]; tn != "" {
+			return internal.TypeNamePrefixCompact + tn
+		}
+		return ""
+	case mapping.FormatPortable:
+		fid, cid := options[prefix+mapping.OptionPortableFactoryID], options[prefix+mapping.OptionPortableClassID]
+		if fid != "" && cid != "" {
+			return fmt.Sprintf("%s%s:%s", internal.TypeNamePrefixPortable, fid, cid)
+		}
+		return ""
+	}
+	return sqlTypeNames[format]
+}
+
+// sampledTypeName returns the type name of the key or the value of an entry in the given Map.
+// Returns an empty string if the Map is empty, or the serialization type does not correspond to a supported type.
+func sampledTypeName(ctx context.Context, ci *hazelcast.ClientInternal, mapName string, key bool) (string, error) {
+	m, err := ci.Client().GetMap(ctx, mapName)
+	if err != nil {
+		return "", err
+	}
+	size, err := m.Size(ctx)
+	if err != nil {
+		return "", err
+	}
+	if size == 0 {
+		return "", nil
+	}
+	keys, values, err := mapping.SampleEntries(ctx, ci, mapName, 1)
+	if err != nil || len(keys) == 0 {
+		return "", err
+	}
+	if key {
+		return dataTypeName(keys[0]), nil
+	}
+	return dataTypeName(values[0]), nil
+}
+
+// dataTypeName returns the type name for the serialization type of the given data.
+func dataTypeName(d hazelcast.Data) string {
+	if d.Type() == serialization.TypePortable && len(d) >= serialization.DataHeaderSize+8 {
+		fid := int32(binary.BigEndian.Uint32(d[serialization.DataHeaderSize:]))
+		cid := int32(binary.BigEndian.Uint32(d[serialization.DataHeaderSize+4:]))
+		return fmt.Sprintf("%s%d:%d", internal.TypeNamePrefixPortable, fid, cid)
+	}
+	return serializationTypeNames[d.Type()]
+}
+
+var serializationTypeNames = map[int32]string{
+	serialization.TypeByte:               internal.TypeNameInt8,
+	serialization.TypeBool:               internal.TypeNameBoolean,
+	serialization.TypeUInt16:             internal.TypeNameChar,
+	serialization.TypeInt16:              internal.TypeNameInt16,
+	serialization.TypeInt32:              internal.TypeNameInt32,
+	serialization.TypeInt64:              internal.TypeNameInt64,
+	serialization.TypeFloat32:            internal.TypeNameFloat32,
+	serialization.TypeFloat64:            internal.TypeNameFloat64,
+	serialization.TypeString:             internal.TypeNameString,
+	serialization.TypeByteArray:          internal.TypeNameBytes,
+	serialization.TypeBoolArray:          internal.TypeNameBoolean + internal.TypeNameSuffixArray,
+	serialization.TypeUInt16Array:        internal.TypeNameChar + internal.TypeNameSuffixArray,
+	serialization.TypeInt16Array:         internal.TypeNameInt16 + internal.TypeNameSuffixArray,
+	serialization.TypeInt32Array:         internal.TypeNameInt32 + internal.TypeNameSuffixArray,
+	serialization.TypeInt64Array:         internal.TypeNameInt64 + internal.TypeNameSuffixArray,
+	serialization.TypeFloat32Array:       internal.TypeNameFloat32 + internal.TypeNameSuffixArray,
+	serialization.TypeFloat64Array:       internal.TypeNameFloat64 + internal.TypeNameSuffixArray,
+	serialization.TypeStringArray:        internal.TypeNameString + internal.TypeNameSuffixArray,
+	serialization.TypeUUID:               internal.TypeNameUUID,
+	serialization.TypeJavaBigInteger:     internal.TypeNameBigInt,
+	serialization.TypeJavaDecimal:        internal.TypeNameDecimal,
+	serialization.TypeJavaLocalDate:      internal.TypeNameDate,
+	serialization.TypeJavaLocalTime:      internal.TypeNameTime,
+	serialization.TypeJavaLocalDateTime:  internal.TypeNameDateTime,
+	serialization.TypeJavaOffsetDateTime: internal.TypeNameDateTimeTZ,
+	serialization.TypeJSONSerialization:  internal.TypeNameJSON,
+}
+
+// sqlTypeNames maps the SQL types which can be used as the key or value format to the type names.
+var sqlTypeNames = map[string]string{
+	"varchar":                  internal.TypeNameString,
+	"boolean":                  internal.TypeNameBoolean,
+	"tinyint":                  internal.TypeNameInt8,
+	"smallint":                 internal.TypeNameInt16,
+	"int":                      internal.TypeNameInt32,
+	"integer":                  internal.TypeNameInt32,
+	"bigint":                   internal.TypeNameInt64,
+	"real":                     internal.TypeNameFloat32,
+	"double":                   internal.TypeNameFloat64,
+	"decimal":                  internal.TypeNameDecimal,
+	"date":                     internal.TypeNameDate,
+	"time":                     internal.TypeNameTime,
+	"timestamp":                internal.TypeNameDateTime,
+	"timestamp with time zone": internal.TypeNameDateTimeTZ,
+}
+
+var javaClassTypeNames = map[string]string{
+	"java.lang.String":         internal.TypeNameString,
+	"java.lang.Boolean":        internal.TypeNameBoolean,
+	"boolean":                  internal.TypeNameBoolean,
+	"java.lang.Byte":           internal.TypeNameInt8,
+	"byte":                     internal.TypeNameInt8,
+	"java.lang.Short":          internal.TypeNameInt16,
+	"short":                    internal.TypeNameInt16,
+	"java.lang.Integer":        internal.TypeNameInt32,
+	"int":                      internal.TypeNameInt32,
+	"java.lang.Long":           internal.TypeNameInt64,
+	"long":                     internal.TypeNameInt64,
+	"java.lang.Float":          internal.TypeNameFloat32,
+	"float":                    internal.TypeNameFloat32,
+	"java.lang.Double":         internal.TypeNameFloat64,
+	"double":                   internal.TypeNameFloat64,
+	"java.lang.Character":      internal.TypeNameChar,
+	"char":                     internal.TypeNameChar,
+	"java.util.UUID":           internal.TypeNameUUID,
+	"java.math.BigInteger":     internal.TypeNameBigInt,
+	"java.math.BigDecimal":     internal.TypeNameDecimal,
+	"java.time.LocalDate":      internal.TypeNameDate,
+	"java.time.LocalTime":      internal.TypeNameTime,
+	"java.time.LocalDateTime":  internal.TypeNameDateTime,
+	"java.time.OffsetDateTime": internal.TypeNameDateTimeTZ,
+}
+
+func init() {
+	plug.Registry.RegisterAugmentor("30-auto-type", &AutoTypeAugmentor{})
+}
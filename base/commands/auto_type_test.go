@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLFormatTypeName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		options  map[string]string
+		prefix   string
+		typeName string
+	}{
+		{name: "SQL type", options: map[string]string{"keyFormat": "bigint"}, prefix: "key", typeName: "i64"},
+		{name: "Java class", options: map[string]string{"valueFormat": "java", "valueJavaClass": "java.util.UUID"}, prefix: "value", typeName: "uuid"},
+		{name: "JSON", options: map[string]string{"valueFormat": "json"}, prefix: "value", typeName: "json"},
+		{name: "Compact", options: map[string]string{"valueFormat": "compact", "valueCompactTypeName": "com.acme.User"}, prefix: "value", typeName: "compact:com.acme.User"},
+		{name: "Portable", options: map[string]string{"keyFormat": "portable", "keyPortableFactoryId": "1", "keyPortableClassId": "2"}, prefix: "key", typeName: "portable:1:2"},
+		{name: "unsupported", options: map[string]string{"valueFormat": "json-flat"}, prefix: "value", typeName: ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.typeName, sqlFormatTypeName(tc.options, tc.prefix))
+		})
+	}
+}
+
+func TestDataTypeName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     hazelcast.Data
+		typeName string
+	}{
+		{name: "int32", data: hazelcast.Data{0, 0, 0, 0, 0xff, 0xff, 0xff, 0xf9, 0, 0, 0, 1}, typeName: "i32"},
+		{name: "portable", data: hazelcast.Data{0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff, 0, 0, 0, 1, 0, 0, 0, 2}, typeName: "portable:1:2"},
+		{name: "unknown", data: hazelcast.Data{0, 0, 0, 0, 0xff, 0xff, 0xff, 0xfe}, typeName: ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.typeName, dataTypeName(tc.data))
+		})
+	}
+}
+
+func TestIsMapCommand(t *testing.T) {
+	testCases := []struct {
+		path   string
+		target bool
+	}{
+		{path: "clc map get", target: true},
+		{path: `\map get`, target: true},
+		{path: "clc set add", target: false},
+		{path: `\set add`, target: false},
+		{path: "map", target: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			require.Equal(t, tc.target, isMapCommand(tc.path))
+		})
+	}
+}
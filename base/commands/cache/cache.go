@@ -0,0 +1,37 @@
+//go:build std || cache
+
+package cache
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/objects"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+type Command struct{}
+
+func (Command) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("cache")
+	cc.AddCommandGroup(clc.GroupDDSID, clc.GroupDDSTitle)
+	cc.SetCommandGroup(clc.GroupDDSID)
+	cc.SetTopLevel(true)
+	help := "Cache operations"
+	cc.SetCommandHelp(help, help)
+	cc.AddStringFlag(base.FlagName, "n", base.DefaultName, false, "cache name")
+	cc.SetFlagCompletion(base.FlagName, objects.NameCompletion("cache"))
+	cc.AddBoolFlag(base.FlagShowType, "", false, false, "add the type names to the output")
+	cc.AddBoolFlag(base.FlagRaw, "", false, false, "show the serialization details of the values which cannot be decoded")
+	return nil
+}
+
+func (Command) Exec(context.Context, plug.ExecContext) error {
+	return nil
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("cache", &Command{}))
+}
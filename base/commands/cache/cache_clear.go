@@ -0,0 +1,14 @@
+//go:build std || cache
+
+package cache
+
+import (
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+func init() {
+	c := commands.NewClearCommand("Cache", "cache", getCache)
+	check.Must(plug.Registry.RegisterCommand("cache:clear", c))
+}
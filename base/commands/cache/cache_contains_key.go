@@ -0,0 +1,58 @@
+//go:build std || cache
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type CacheContainsKeyCommand struct{}
+
+func (CacheContainsKeyCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("contains-key")
+	help := "Check whether the given Cache contains the key"
+	cc.SetCommandHelp(help, help)
+	commands.AddKeyTypeFlag(cc)
+	cc.AddStringArg(commands.ArgKey, commands.ArgTitleKey)
+	return nil
+}
+
+func (CacheContainsKeyCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	ok, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (bool, error) {
+		c, err := getCache(ctx, ec, sp)
+		if err != nil {
+			return false, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.cache")
+		sp.SetText(fmt.Sprintf("Checking the key in Cache '%s'", name))
+		kd, err := commands.MakeKeyData(ctx, ec, c.ci, ec.GetStringArg(commands.ArgKey))
+		if err != nil {
+			return false, err
+		}
+		req := codec.EncodeCacheContainsKeyRequest(c.name, kd)
+		resp, err := c.ci.InvokeOnKey(ctx, req, kd, nil)
+		if err != nil {
+			return false, err
+		}
+		return codec.DecodeCacheContainsKeyResponse(resp), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return ec.AddOutputRows(ctx, makeBoolRow("Contains", ok))
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("cache:contains-key", &CacheContainsKeyCommand{}))
+}
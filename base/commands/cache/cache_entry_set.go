@@ -0,0 +1,83 @@
+//go:build std || cache
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hazelcast/hazelcast-go-client"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+const entrySetBatchSize = 100
+
+type CacheEntrySetCommand struct{}
+
+func (CacheEntrySetCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("entry-set")
+	help := "Get all entries of a Cache"
+	cc.SetCommandHelp(help, help)
+	return nil
+}
+
+func (CacheEntrySetCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	showType := ec.Props().GetBool(base.FlagShowType)
+	raw := ec.Props().GetBool(base.FlagRaw)
+	rows, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) ([]output.Row, error) {
+		c, err := getCache(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.cache")
+		sp.SetText(fmt.Sprintf("Getting entries of Cache '%s'", name))
+		var rows []output.Row
+		for pid := int32(0); pid < c.ci.PartitionCount(); pid++ {
+			pairs, err := iteratePartition(ctx, c, pid)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, output.DecodePairs(c.ci, pairs, showType, raw)...)
+		}
+		return rows, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return commands.AddDDSRows(ctx, ec, "Cache", "entries", rows)
+}
+
+// iteratePartition returns all entries in the given partition of the cache.
+func iteratePartition(ctx context.Context, c *cacheProxy, partitionID int32) ([]hazelcast.Pair, error) {
+	// the initial pointer starts the iteration from the beginning of the partition
+	pointers := []hazelcast.Pair{hazelcast.NewPair(int32(math.MaxInt32), int32(-1))}
+	var result []hazelcast.Pair
+	for {
+		req := codec.EncodeCacheIterateEntriesRequest(c.name, pointers, entrySetBatchSize)
+		resp, err := c.ci.InvokeOnPartition(ctx, req, partitionID, nil)
+		if err != nil {
+			return nil, err
+		}
+		var entries []hazelcast.Pair
+		pointers, entries = codec.DecodeCacheIterateEntriesResponse(resp)
+		result = append(result, entries...)
+		if len(entries) == 0 || len(pointers) == 0 || pointers[len(pointers)-1].Value.(int32) < 0 {
+			return result, nil
+		}
+	}
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("cache:entry-set", &CacheEntrySetCommand{}))
+}
@@ -0,0 +1,64 @@
+//go:build std || cache
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type CacheGetCommand struct{}
+
+func (CacheGetCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("get")
+	help := "Get a value from the given Cache"
+	cc.SetCommandHelp(help, help)
+	commands.AddKeyTypeFlag(cc)
+	addExpiryPolicyFlag(cc)
+	cc.AddStringArg(commands.ArgKey, commands.ArgTitleKey)
+	return nil
+}
+
+func (CacheGetCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	row, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (output.Row, error) {
+		c, err := getCache(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.cache")
+		sp.SetText(fmt.Sprintf("Getting from Cache '%s'", name))
+		kd, err := commands.MakeKeyData(ctx, ec, c.ci, ec.GetStringArg(commands.ArgKey))
+		if err != nil {
+			return nil, err
+		}
+		ep, err := makeExpiryPolicyData(ec, c.ci)
+		if err != nil {
+			return nil, err
+		}
+		req := codec.EncodeCacheGetRequest(c.name, kd, ep)
+		resp, err := c.ci.InvokeOnKey(ctx, req, kd, nil)
+		if err != nil {
+			return nil, err
+		}
+		return makeValueRow(ec, c.ci, codec.DecodeCacheGetResponse(resp)), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return ec.AddOutputRows(ctx, row)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("cache:get", &CacheGetCommand{}))
+}
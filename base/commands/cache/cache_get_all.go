@@ -0,0 +1,76 @@
+//go:build std || cache
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type CacheGetAllCommand struct{}
+
+func (CacheGetAllCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("get-all")
+	long := `Get the entries with the given keys from the given Cache
+
+Keys which do not exist in the Cache are not included in the output.`
+	short := "Get the entries with the given keys from the given Cache"
+	cc.SetCommandHelp(long, short)
+	commands.AddKeyTypeFlag(cc)
+	addExpiryPolicyFlag(cc)
+	cc.AddStringSliceArg(argKeys, argTitleKeys, 1, clc.MaxArgs)
+	return nil
+}
+
+func (CacheGetAllCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	showType := ec.Props().GetBool(base.FlagShowType)
+	raw := ec.Props().GetBool(base.FlagRaw)
+	rows, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) ([]output.Row, error) {
+		c, err := getCache(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.cache")
+		sp.SetText(fmt.Sprintf("Getting entries from Cache '%s'", name))
+		keys := ec.GetStringSliceArg(argKeys)
+		kds := make([]hazelcast.Data, len(keys))
+		for i, k := range keys {
+			kd, err := commands.MakeKeyData(ctx, ec, c.ci, k)
+			if err != nil {
+				return nil, err
+			}
+			kds[i] = kd
+		}
+		ep, err := makeExpiryPolicyData(ec, c.ci)
+		if err != nil {
+			return nil, err
+		}
+		req := codec.EncodeCacheGetAllRequest(c.name, kds, ep)
+		resp, err := c.ci.InvokeOnRandomTarget(ctx, req, nil)
+		if err != nil {
+			return nil, err
+		}
+		return output.DecodePairs(c.ci, codec.DecodeCacheGetAllResponse(resp), showType, raw), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return commands.AddDDSRows(ctx, ec, "Cache", "entries", rows)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("cache:get-all", &CacheGetAllCommand{}))
+}
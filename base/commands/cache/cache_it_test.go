@@ -0,0 +1,136 @@
+//go:build std || cache
+
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/it"
+)
+
+func TestCache(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(t *testing.T)
+	}{
+		{name: "Clear_NonInteractive", f: clear_NonInteractiveTest},
+		{name: "ContainsKey_NonInteractive", f: containsKey_NonInteractiveTest},
+		{name: "EntrySet_NonInteractive", f: entrySet_NonInteractiveTest},
+		{name: "GetAll_NonInteractive", f: getAll_NonInteractiveTest},
+		{name: "PutGet_NonInteractive", f: putGet_NonInteractiveTest},
+		{name: "PutIfAbsent_NonInteractive", f: putIfAbsent_NonInteractiveTest},
+		{name: "Remove_NonInteractive", f: remove_NonInteractiveTest},
+		{name: "Size_NonInteractive", f: size_NonInteractiveTest},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, tc.f)
+	}
+}
+
+func clear_NonInteractiveTest(t *testing.T) {
+	it.CacheTester(t, func(tcx it.TestContext, name string) {
+		ctx := context.Background()
+		tcx.CLCExecute(ctx, "cache", "-n", name, "put", "foo", "bar", "-q")
+		check.Must(tcx.CLC().Execute(ctx, "cache", "-n", name, "clear", "-q", "--yes"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cache", "-n", name, "size", "-q"))
+			tcx.AssertStdoutEquals("0\n")
+		})
+	})
+}
+
+func containsKey_NonInteractiveTest(t *testing.T) {
+	it.CacheTester(t, func(tcx it.TestContext, name string) {
+		ctx := context.Background()
+		tcx.CLCExecute(ctx, "cache", "-n", name, "put", "foo", "bar", "-q")
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cache", "-n", name, "contains-key", "foo", "-q"))
+			tcx.AssertStdoutEquals("true\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cache", "-n", name, "contains-key", "baz", "-q"))
+			tcx.AssertStdoutEquals("false\n")
+		})
+	})
+}
+
+func entrySet_NonInteractiveTest(t *testing.T) {
+	it.CacheTester(t, func(tcx it.TestContext, name string) {
+		ctx := context.Background()
+		tcx.CLCExecute(ctx, "cache", "-n", name, "put", "foo", "bar", "-q")
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cache", "-n", name, "entry-set", "-q", "--show-type"))
+			tcx.AssertStdoutEquals("foo\tSTRING\tbar\tSTRING\n")
+		})
+	})
+}
+
+func getAll_NonInteractiveTest(t *testing.T) {
+	it.CacheTester(t, func(tcx it.TestContext, name string) {
+		ctx := context.Background()
+		tcx.CLCExecute(ctx, "cache", "-n", name, "put", "foo", "bar", "-q")
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cache", "-n", name, "get-all", "foo", "baz", "-q"))
+			tcx.AssertStdoutEquals("foo\tbar\n")
+		})
+	})
+}
+
+func putGet_NonInteractiveTest(t *testing.T) {
+	it.CacheTester(t, func(tcx it.TestContext, name string) {
+		ctx := context.Background()
+		tcx.CLCExecute(ctx, "cache", "-n", name, "put", "foo", "42", "-v", "i32", "--expiry-policy", "create=1m", "-q")
+		tcx.WithReset(func() {
+			// the name with the cache manager prefix refers to the same cache
+			check.Must(tcx.CLC().Execute(ctx, "cache", "-n", "/hz/"+name, "get", "foo", "-q", "--show-type"))
+			tcx.AssertStdoutEquals("42\tINT32\n")
+		})
+	})
+}
+
+func putIfAbsent_NonInteractiveTest(t *testing.T) {
+	it.CacheTester(t, func(tcx it.TestContext, name string) {
+		ctx := context.Background()
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cache", "-n", name, "put-if-absent", "foo", "bar", "-q"))
+			tcx.AssertStdoutEquals("true\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cache", "-n", name, "put-if-absent", "foo", "baz", "-q"))
+			tcx.AssertStdoutEquals("false\n")
+		})
+	})
+}
+
+func remove_NonInteractiveTest(t *testing.T) {
+	it.CacheTester(t, func(tcx it.TestContext, name string) {
+		ctx := context.Background()
+		tcx.CLCExecute(ctx, "cache", "-n", name, "put", "foo", "bar", "-q")
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cache", "-n", name, "remove", "foo", "-q"))
+			tcx.AssertStdoutEquals("true\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cache", "-n", name, "size", "-q"))
+			tcx.AssertStdoutEquals("0\n")
+		})
+	})
+}
+
+func size_NonInteractiveTest(t *testing.T) {
+	it.CacheTester(t, func(tcx it.TestContext, name string) {
+		ctx := context.Background()
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cache", "-n", name, "size", "-q"))
+			tcx.AssertStdoutEquals("0\n")
+		})
+		tcx.CLCExecute(ctx, "cache", "-n", name, "put", "foo", "bar", "-q")
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cache", "-n", name, "size", "-q"))
+			tcx.AssertStdoutEquals("1\n")
+		})
+	})
+}
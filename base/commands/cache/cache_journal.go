@@ -0,0 +1,59 @@
+//go:build std || cache
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+func getCacheName(ctx context.Context, ec plug.ExecContext, sp clc.Spinner) (string, error) {
+	c, err := getCache(ctx, ec, sp)
+	if err != nil {
+		return "", err
+	}
+	return c.name, nil
+}
+
+// cacheEventTypeLabel returns the name of the given com.hazelcast.cache.CacheEventType.
+func cacheEventTypeLabel(t int32) string {
+	switch t {
+	case 1:
+		return "CREATED"
+	case 2:
+		return "UPDATED"
+	case 3:
+		return "REMOVED"
+	case 4:
+		return "EXPIRED"
+	case 5:
+		return "EVICTED"
+	case 6:
+		return "INVALIDATED"
+	case 7:
+		return "COMPLETED"
+	case 8:
+		return "EXPIRATION_TIME_UPDATED"
+	case 9:
+		return "PARTITION_LOST"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", t)
+	}
+}
+
+func init() {
+	codecs := commands.JournalCodecs{
+		EncodeSubscribe: codec.EncodeCacheEventJournalSubscribeRequest,
+		DecodeSubscribe: codec.DecodeCacheEventJournalSubscribeResponse,
+		EncodeRead:      codec.EncodeCacheEventJournalReadRequest,
+		DecodeRead:      codec.DecodeCacheEventJournalReadResponse,
+	}
+	c := commands.NewJournalCommand("Cache", "cache", codecs, getCacheName, cacheEventTypeLabel)
+	check.Must(plug.Registry.RegisterCommand("cache:journal", c))
+}
@@ -0,0 +1,68 @@
+//go:build std || cache
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type CachePutCommand struct{}
+
+func (CachePutCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("put")
+	help := "Put a value in the given Cache"
+	cc.SetCommandHelp(help, help)
+	commands.AddKeyTypeFlag(cc)
+	commands.AddValueTypeFlag(cc)
+	addExpiryPolicyFlag(cc)
+	cc.AddStringArg(commands.ArgKey, commands.ArgTitleKey)
+	cc.AddStringArg(base.ArgValue, base.ArgTitleValue)
+	return nil
+}
+
+func (CachePutCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	_, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		c, err := getCache(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.cache")
+		sp.SetText(fmt.Sprintf("Putting value into Cache '%s'", name))
+		key := ec.GetStringArg(commands.ArgKey)
+		value := ec.GetStringArg(base.ArgValue)
+		kd, vd, err := commands.MakeKeyValueData(ctx, ec, c.ci, key, value)
+		if err != nil {
+			return nil, err
+		}
+		ep, err := makeExpiryPolicyData(ec, c.ci)
+		if err != nil {
+			return nil, err
+		}
+		req := codec.EncodeCachePutRequest(c.name, kd, vd, ep, false, ignoreCompletion)
+		if _, err = c.ci.InvokeOnKey(ctx, req, kd, nil); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	msg := fmt.Sprintf("OK Put the value into the Cache '%s'.", name)
+	ec.PrintlnUnnecessary(msg)
+	return nil
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("cache:put", &CachePutCommand{}))
+}
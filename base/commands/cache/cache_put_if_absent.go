@@ -0,0 +1,70 @@
+//go:build std || cache
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type CachePutIfAbsentCommand struct{}
+
+func (CachePutIfAbsentCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("put-if-absent")
+	long := `Put a value in the given Cache if the key does not exist
+
+Outputs true if the value was put, false otherwise.`
+	short := "Put a value in the given Cache if the key does not exist"
+	cc.SetCommandHelp(long, short)
+	commands.AddKeyTypeFlag(cc)
+	commands.AddValueTypeFlag(cc)
+	addExpiryPolicyFlag(cc)
+	cc.AddStringArg(commands.ArgKey, commands.ArgTitleKey)
+	cc.AddStringArg(base.ArgValue, base.ArgTitleValue)
+	return nil
+}
+
+func (CachePutIfAbsentCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	ok, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (bool, error) {
+		c, err := getCache(ctx, ec, sp)
+		if err != nil {
+			return false, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.cache")
+		sp.SetText(fmt.Sprintf("Putting value into Cache '%s'", name))
+		key := ec.GetStringArg(commands.ArgKey)
+		value := ec.GetStringArg(base.ArgValue)
+		kd, vd, err := commands.MakeKeyValueData(ctx, ec, c.ci, key, value)
+		if err != nil {
+			return false, err
+		}
+		ep, err := makeExpiryPolicyData(ec, c.ci)
+		if err != nil {
+			return false, err
+		}
+		req := codec.EncodeCachePutIfAbsentRequest(c.name, kd, vd, ep, ignoreCompletion)
+		resp, err := c.ci.InvokeOnKey(ctx, req, kd, nil)
+		if err != nil {
+			return false, err
+		}
+		return codec.DecodeCachePutIfAbsentResponse(resp), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return ec.AddOutputRows(ctx, makeBoolRow("Put", ok))
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("cache:put-if-absent", &CachePutIfAbsentCommand{}))
+}
@@ -0,0 +1,61 @@
+//go:build std || cache
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type CacheRemoveCommand struct{}
+
+func (CacheRemoveCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("remove")
+	long := `Remove a value from the given Cache
+
+Outputs true if the entry was removed, false otherwise.`
+	short := "Remove a value from the given Cache"
+	cc.SetCommandHelp(long, short)
+	commands.AddKeyTypeFlag(cc)
+	cc.AddStringArg(commands.ArgKey, commands.ArgTitleKey)
+	return nil
+}
+
+func (CacheRemoveCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	ok, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (bool, error) {
+		c, err := getCache(ctx, ec, sp)
+		if err != nil {
+			return false, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.cache")
+		sp.SetText(fmt.Sprintf("Removing from Cache '%s'", name))
+		kd, err := commands.MakeKeyData(ctx, ec, c.ci, ec.GetStringArg(commands.ArgKey))
+		if err != nil {
+			return false, err
+		}
+		req := codec.EncodeCacheRemoveRequest(c.name, kd, nil, ignoreCompletion)
+		resp, err := c.ci.InvokeOnKey(ctx, req, kd, nil)
+		if err != nil {
+			return false, err
+		}
+		return codec.DecodeCacheRemoveResponse(resp), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return ec.AddOutputRows(ctx, makeBoolRow("Removed", ok))
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("cache:remove", &CacheRemoveCommand{}))
+}
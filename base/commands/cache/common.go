@@ -0,0 +1,175 @@
+//go:build std || cache
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+// cacheProxy is a minimal JCache proxy which talks to the cluster using the cache codecs.
+type cacheProxy struct {
+	ci   *hazelcast.ClientInternal
+	name string
+}
+
+func (c *cacheProxy) Size(ctx context.Context) (int, error) {
+	req := codec.EncodeCacheSizeRequest(c.name)
+	resp, err := c.ci.InvokeOnRandomTarget(ctx, req, nil)
+	if err != nil {
+		return 0, err
+	}
+	return int(codec.DecodeCacheSizeResponse(resp)), nil
+}
+
+func (c *cacheProxy) Clear(ctx context.Context) error {
+	req := codec.EncodeCacheClearRequest(c.name)
+	_, err := c.ci.InvokeOnRandomTarget(ctx, req, nil)
+	return err
+}
+
+func (c *cacheProxy) Destroy(ctx context.Context) error {
+	req := codec.EncodeCacheDestroyRequest(c.name)
+	_, err := c.ci.InvokeOnRandomTarget(ctx, req, nil)
+	return err
+}
+
+// getCache returns the proxy for the cache with the name in the name flag.
+// The cache configuration is created on the cluster if it does not exist yet.
+func getCache(ctx context.Context, ec plug.ExecContext, sp clc.Spinner) (*cacheProxy, error) {
+	name := ec.Props().GetString(base.FlagName)
+	ci, err := cmd.ClientInternal(ctx, ec, sp)
+	if err != nil {
+		return nil, err
+	}
+	sp.SetText(fmt.Sprintf("Getting Cache '%s'", name))
+	prefixed, simple := cacheNames(name)
+	req := codec.EncodeCacheGetConfigRequest(prefixed, simple)
+	resp, err := ci.InvokeOnRandomTarget(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	cfg := codec.DecodeCacheGetConfigResponse(resp)
+	if cfg == nil {
+		return nil, fmt.Errorf("cache '%s' is not configured on the cluster", simple)
+	}
+	req = codec.EncodeCacheCreateConfigRequest(cfg, true)
+	if _, err := ci.InvokeOnRandomTarget(ctx, req, nil); err != nil {
+		return nil, err
+	}
+	return &cacheProxy{ci: ci, name: prefixed}, nil
+}
+
+// cacheNames returns the name of the cache with and without the cache manager prefix.
+// The name may be given with or without the prefix.
+func cacheNames(name string) (prefixed, simple string) {
+	simple = strings.TrimPrefix(name, cacheManagerPrefix)
+	return cacheManagerPrefix + simple, simple
+}
+
+func addExpiryPolicyFlag(cc plug.InitContext) {
+	help := "expiry policy durations, e.g., create=10s,access=1m,update=5000 (ms if no unit is given)"
+	cc.AddStringFlag(cacheFlagExpiryPolicy, "", "", false, help)
+}
+
+// makeExpiryPolicyData returns the serialized expiry policy given in the expiry policy flag.
+// Returns nil if the flag was not specified.
+func makeExpiryPolicyData(ec plug.ExecContext, ci *hazelcast.ClientInternal) (hazelcast.Data, error) {
+	s := ec.Props().GetString(cacheFlagExpiryPolicy)
+	if s == "" {
+		return nil, nil
+	}
+	p, err := parseExpiryPolicy(s)
+	if err != nil {
+		return nil, err
+	}
+	return ci.EncodeData(p)
+}
+
+func parseExpiryPolicy(s string) (*serialization.ExpiryPolicy, error) {
+	p := &serialization.ExpiryPolicy{
+		Create: -1,
+		Access: -1,
+		Update: -1,
+	}
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid expiry policy item: %s", item)
+		}
+		d, err := parseDuration(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration for %s: %w", k, err)
+		}
+		switch strings.TrimSpace(k) {
+		case "create":
+			p.Create = d
+		case "access":
+			p.Access = d
+		case "update":
+			p.Update = d
+		default:
+			return nil, fmt.Errorf("unknown expiry policy item: %s (should be one of: create, access, update)", k)
+		}
+	}
+	return p, nil
+}
+
+func parseDuration(s string) (time.Duration, error) {
+	// plain numbers are in milliseconds
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if ms < 0 {
+			return 0, fmt.Errorf("duration cannot be negative: %s", s)
+		}
+		return time.Duration(ms) * time.Millisecond, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("duration cannot be negative: %s", s)
+	}
+	return d, nil
+}
+
+func makeValueRow(ec plug.ExecContext, ci *hazelcast.ClientInternal, data hazelcast.Data) output.Row {
+	vt := data.Type()
+	value, err := ci.DecodeData(data)
+	if err != nil {
+		ec.Logger().Info("The value was not decoded, due to error: %s", err.Error())
+		value = serialization.NondecodedValue(data, ec.Props().GetBool(base.FlagRaw))
+	}
+	row := output.Row{output.NewValueColumn(vt, value)}
+	if ec.Props().GetBool(base.FlagShowType) {
+		row = append(row, output.NewValueTypeColumn(vt))
+	}
+	return row
+}
+
+func makeBoolRow(name string, value bool) output.Row {
+	return output.Row{
+		output.Column{
+			Name:  name,
+			Type:  serialization.TypeBool,
+			Value: value,
+		},
+	}
+}
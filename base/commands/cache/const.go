@@ -0,0 +1,13 @@
+//go:build std || cache
+
+package cache
+
+const (
+	cacheFlagExpiryPolicy = "expiry-policy"
+	argKeys               = "keys"
+	argTitleKeys          = "key"
+	// cacheManagerPrefix is the prefix added to the cache names by the JCache cache manager.
+	cacheManagerPrefix = "/hz/"
+	// ignoreCompletion is the completion ID sent when no completion event is expected.
+	ignoreCompletion = -1
+)
@@ -0,0 +1,3 @@
+package cache
+
+// This file exists only for compilation
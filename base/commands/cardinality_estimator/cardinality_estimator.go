@@ -0,0 +1,35 @@
+//go:build std || cardinalityestimator
+
+package cardinalityestimator
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/objects"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+type Command struct{}
+
+func (Command) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("cardinality-estimator")
+	cc.AddCommandGroup(clc.GroupDDSID, clc.GroupDDSTitle)
+	cc.SetCommandGroup(clc.GroupDDSID)
+	cc.SetTopLevel(true)
+	help := "CardinalityEstimator operations"
+	cc.SetCommandHelp(help, help)
+	cc.AddStringFlag(base.FlagName, "n", base.DefaultName, false, "CardinalityEstimator name")
+	cc.SetFlagCompletion(base.FlagName, objects.NameCompletion("cardinalityestimator"))
+	return nil
+}
+
+func (Command) Exec(context.Context, plug.ExecContext) error {
+	return nil
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("cardinality-estimator", &Command{}))
+}
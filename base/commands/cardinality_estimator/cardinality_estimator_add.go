@@ -0,0 +1,93 @@
+//go:build std || cardinalityestimator
+
+package cardinalityestimator
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type AddCommand struct{}
+
+func (AddCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("add")
+	long := `Add values to the CardinalityEstimator
+
+If no values are given, the values are read from the standard input, one value per line.
+Empty lines are skipped.`
+	short := "Add values to the CardinalityEstimator"
+	cc.SetCommandHelp(long, short)
+	commands.AddValueTypeFlag(cc)
+	cc.AddStringSliceArg(argValues, argTitleValues, 0, clc.MaxArgs)
+	return nil
+}
+
+func (AddCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	values := ec.GetStringSliceArg(argValues)
+	if len(values) == 0 && ec.Mode() == plug.ModeInteractive {
+		return errors.New("values are required in the interactive mode")
+	}
+	count, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (int, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return 0, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.cardinalityestimator")
+		sp.SetText(fmt.Sprintf("Adding values to CardinalityEstimator %s", name))
+		var count int
+		add := func(value string) error {
+			vd, err := commands.MakeValueData(ctx, ec, ci, value)
+			if err != nil {
+				return err
+			}
+			req := codec.EncodeCardinalityEstimatorAddRequest(name, hashData(vd))
+			if _, err := invoke(ctx, ci, name, req); err != nil {
+				return err
+			}
+			count++
+			return nil
+		}
+		if len(values) > 0 {
+			for _, v := range values {
+				if err := add(v); err != nil {
+					return count, err
+				}
+			}
+			return count, nil
+		}
+		sc := bufio.NewScanner(ec.Stdin())
+		for sc.Scan() {
+			line := sc.Text()
+			if line == "" {
+				continue
+			}
+			if err := add(line); err != nil {
+				return count, err
+			}
+			sp.SetText(fmt.Sprintf("Added %d values to CardinalityEstimator %s", count, name))
+		}
+		return count, sc.Err()
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	msg := fmt.Sprintf("OK Added %d values to CardinalityEstimator '%s'.", count, name)
+	ec.PrintlnUnnecessary(msg)
+	return nil
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("cardinality-estimator:add", &AddCommand{}))
+}
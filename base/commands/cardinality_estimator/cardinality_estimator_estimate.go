@@ -0,0 +1,60 @@
+//go:build std || cardinalityestimator
+
+package cardinalityestimator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+type EstimateCommand struct{}
+
+func (EstimateCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("estimate")
+	help := "Estimate the number of distinct values added to the CardinalityEstimator"
+	cc.SetCommandHelp(help, help)
+	return nil
+}
+
+func (EstimateCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	row, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (output.Row, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.cardinalityestimator")
+		sp.SetText(fmt.Sprintf("Getting the estimate of CardinalityEstimator %s", name))
+		req := codec.EncodeCardinalityEstimatorEstimateRequest(name)
+		resp, err := invoke(ctx, ci, name, req)
+		if err != nil {
+			return nil, err
+		}
+		row := output.Row{
+			output.Column{
+				Name:  "Estimate",
+				Type:  serialization.TypeInt64,
+				Value: codec.DecodeCardinalityEstimatorEstimateResponse(resp),
+			},
+		}
+		return row, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return ec.AddOutputRows(ctx, row)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("cardinality-estimator:estimate", &EstimateCommand{}))
+}
@@ -0,0 +1,49 @@
+//go:build std || cardinalityestimator
+
+package cardinalityestimator_test
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/it"
+)
+
+func TestCardinalityEstimator(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(t *testing.T)
+	}{
+		{name: "Add_NonInteractive", f: add_NonInteractiveTest},
+		{name: "Estimate_NonInteractive", f: estimate_NonInteractiveTest},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, tc.f)
+	}
+}
+
+func add_NonInteractiveTest(t *testing.T) {
+	it.CardinalityEstimatorTester(t, func(tcx it.TestContext, name string) {
+		ctx := context.Background()
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cardinality-estimator", "-n", name, "add", "foo", "bar", "foo"))
+			tcx.AssertStdoutContains("OK Added 3 values")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cardinality-estimator", "-n", name, "estimate", "-q"))
+			tcx.AssertStdoutEquals("2\n")
+		})
+	})
+}
+
+func estimate_NonInteractiveTest(t *testing.T) {
+	it.CardinalityEstimatorTester(t, func(tcx it.TestContext, name string) {
+		ctx := context.Background()
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "cardinality-estimator", "-n", name, "estimate", "-q"))
+			tcx.AssertStdoutEquals("0\n")
+		})
+	})
+}
@@ -0,0 +1,26 @@
+//go:build std || cardinalityestimator
+
+package cardinalityestimator
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client"
+
+	"github.com/hazelcast/hazelcast-commandline-client/internal"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/murmur"
+)
+
+func invoke(ctx context.Context, ci *hazelcast.ClientInternal, name string, req *hazelcast.ClientMessage) (*hazelcast.ClientMessage, error) {
+	pID, err := internal.StringToPartitionID(ci, name)
+	if err != nil {
+		return nil, err
+	}
+	return ci.InvokeOnPartition(ctx, req, pID, nil)
+}
+
+// hashData returns the hash of the given serialized value, as computed by the Java client.
+func hashData(d hazelcast.Data) int64 {
+	// the header of the serialized value is not included in the hash
+	return murmur.Hash64(d[dataOffset:])
+}
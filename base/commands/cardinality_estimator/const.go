@@ -0,0 +1,10 @@
+//go:build std || cardinalityestimator
+
+package cardinalityestimator
+
+const (
+	argValues      = "values"
+	argTitleValues = "value"
+	// dataOffset is the size of the header of a serialized value.
+	dataOffset = 8
+)
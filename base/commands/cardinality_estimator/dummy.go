@@ -0,0 +1,3 @@
+package cardinalityestimator
+
+// This file exists only for compilation
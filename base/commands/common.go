@@ -209,12 +209,12 @@ func (cm SizeCommand[T]) Exec(ctx context.Context, ec plug.ExecContext) error {
 }
 
 func AddKeyTypeFlag(cc plug.InitContext) {
-	help := fmt.Sprintf("key type (one of: %s)", strings.Join(internal.SupportedTypeNames, ", "))
+	help := fmt.Sprintf("key type (one of: %s, %sTYPE, %sFACTORY:CLASS)", strings.Join(internal.SupportedTypeNames, ", "), internal.TypeNamePrefixCompact, internal.TypeNamePrefixPortable)
 	cc.AddStringFlag(base.FlagKeyType, "k", "string", false, help)
 }
 
 func AddValueTypeFlag(cc plug.InitContext) {
-	help := fmt.Sprintf("value type (one of: %s)", strings.Join(internal.SupportedTypeNames, ", "))
+	help := fmt.Sprintf("value type (one of: %s, %sTYPE, %sFACTORY:CLASS)", strings.Join(internal.SupportedTypeNames, ", "), internal.TypeNamePrefixCompact, internal.TypeNamePrefixPortable)
 	cc.AddStringFlag(FlagValueType, "v", "string", false, help)
 }
 
@@ -240,11 +240,12 @@ func MakeValuesFromStrings(typeStr string, items []string) ([]any, error) {
 	return vs, nil
 }
 
-func MakeKeyData(ec plug.ExecContext, ci *hazelcast.ClientInternal, keyStr string) (hazelcast.Data, error) {
+func MakeKeyData(ctx context.Context, ec plug.ExecContext, ci *hazelcast.ClientInternal, keyStr string) (hazelcast.Data, error) {
 	kt := ec.Props().GetString(FlagKeyType)
 	if kt == "" {
 		kt = "string"
 	}
+	kt = resolveTypeName(ctx, ec, ci, kt, true)
 	key, err := mk.ValueFromString(keyStr, kt)
 	if err != nil {
 		return nil, err
@@ -252,11 +253,12 @@ func MakeKeyData(ec plug.ExecContext, ci *hazelcast.ClientInternal, keyStr strin
 	return ci.EncodeData(key)
 }
 
-func MakeValueData(ec plug.ExecContext, ci *hazelcast.ClientInternal, valueStr string) (hazelcast.Data, error) {
+func MakeValueData(ctx context.Context, ec plug.ExecContext, ci *hazelcast.ClientInternal, valueStr string) (hazelcast.Data, error) {
 	vt := ec.Props().GetString(base.FlagValueType)
 	if vt == "" {
 		vt = "string"
 	}
+	vt = resolveTypeName(ctx, ec, ci, vt, false)
 	value, err := mk.ValueFromString(valueStr, vt)
 	if err != nil {
 		return nil, err
@@ -264,12 +266,12 @@ func MakeValueData(ec plug.ExecContext, ci *hazelcast.ClientInternal, valueStr s
 	return ci.EncodeData(value)
 }
 
-func MakeKeyValueData(ec plug.ExecContext, ci *hazelcast.ClientInternal, keyStr, valueStr string) (hazelcast.Data, hazelcast.Data, error) {
-	kd, err := MakeKeyData(ec, ci, keyStr)
+func MakeKeyValueData(ctx context.Context, ec plug.ExecContext, ci *hazelcast.ClientInternal, keyStr, valueStr string) (hazelcast.Data, hazelcast.Data, error) {
+	kd, err := MakeKeyData(ctx, ec, ci, keyStr)
 	if err != nil {
 		return nil, nil, err
 	}
-	vd, err := MakeValueData(ec, ci, valueStr)
+	vd, err := MakeValueData(ctx, ec, ci, valueStr)
 	if err != nil {
 		return nil, nil, err
 	}
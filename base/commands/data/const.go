@@ -0,0 +1,8 @@
+//go:build std || data
+
+package data
+
+const (
+	argData      = "data"
+	argTitleData = "data"
+)
@@ -0,0 +1,28 @@
+//go:build std || data
+
+package data
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+type Command struct{}
+
+func (Command) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("data")
+	cc.SetTopLevel(true)
+	help := "Serialized data operations"
+	cc.SetCommandHelp(help, help)
+	return nil
+}
+
+func (Command) Exec(context.Context, plug.ExecContext) error {
+	return nil
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("data", &Command{}))
+}
@@ -0,0 +1,108 @@
+//go:build std || data
+
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+type InspectCommand struct{}
+
+func (InspectCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("inspect")
+	long := `Shows the serialization details of the given serialized data without decoding it
+
+The data is the serialized form of a value including its header, given as a hex string prefixed with 0x or a base64 string.
+The output contains:
+  * the serialization type ID and the partition hash,
+  * the factory ID and the class ID for IdentifiedDataSerializable and Portable values,
+  * the schema ID for Compact values,
+  * the class name and the fields of the top level class for Java serialized values,
+  * the payload as a hex and a base64 string.
+`
+	short := "Shows the serialization details of the given serialized data"
+	cc.SetCommandHelp(long, short)
+	cc.AddStringSliceArg(argData, argTitleData, 1, clc.MaxArgs)
+	return nil
+}
+
+func (InspectCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	cmd.IncrementMetric(ctx, ec, "total.data")
+	var rows []output.Row
+	for _, s := range ec.GetStringSliceArg(argData) {
+		v, err := internal.ConvertString(s, internal.TypeNameBytes)
+		if err != nil {
+			return err
+		}
+		rd, err := serialization.InspectData(v.([]byte))
+		if err != nil {
+			return err
+		}
+		rows = append(rows, makeRow(rd))
+	}
+	return ec.AddOutputRows(ctx, rows...)
+}
+
+func makeRow(rd serialization.RawData) output.Row {
+	return output.Row{
+		output.Column{
+			Name:  "Type ID",
+			Type:  serialization.TypeInt32,
+			Value: rd.TypeID,
+		},
+		output.Column{
+			Name:  "Type",
+			Type:  serialization.TypeString,
+			Value: rd.TypeLabel(),
+		},
+		output.Column{
+			Name:  "Partition Hash",
+			Type:  serialization.TypeInt32,
+			Value: rd.PartitionHash,
+		},
+		optionalColumn("Factory ID", serialization.TypeInt32, rd.FactoryID),
+		optionalColumn("Class ID", serialization.TypeInt32, rd.ClassID),
+		optionalColumn("Schema ID", serialization.TypeInt64, rd.SchemaID),
+		stringColumn("Class Name", rd.ClassName),
+		stringColumn("Fields", rd.JavaFieldsText()),
+		stringColumn("Enum Constant", rd.EnumConstant),
+		output.Column{
+			Name:  "Hex",
+			Type:  serialization.TypeString,
+			Value: fmt.Sprintf("0x%s", rd.Hex()),
+		},
+		output.Column{
+			Name:  "Base64",
+			Type:  serialization.TypeString,
+			Value: rd.Base64(),
+		},
+	}
+}
+
+// optionalColumn returns a column with the given value, or a nil column if the value is not set.
+func optionalColumn[T any](name string, typ int32, v *T) output.Column {
+	if v == nil {
+		return output.Column{Name: name, Type: serialization.TypeNil}
+	}
+	return output.Column{Name: name, Type: typ, Value: *v}
+}
+
+func stringColumn(name, v string) output.Column {
+	if v == "" {
+		return output.Column{Name: name, Type: serialization.TypeNil}
+	}
+	return output.Column{Name: name, Type: serialization.TypeString, Value: v}
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("data:inspect", &InspectCommand{}))
+}
@@ -0,0 +1,3 @@
+package data
+
+// This file exists only for compilation
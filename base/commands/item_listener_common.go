@@ -0,0 +1,170 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/types"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+const flagItemListenerCount = "count"
+
+// item event types, see: com.hazelcast.core.ItemEventType
+const (
+	itemEventAdded   = 1
+	itemEventRemoved = 2
+)
+
+type ItemListenerAddEncodeFunc func(name string, includeValue, localOnly bool) *hazelcast.ClientMessage
+type ItemListenerRemoveEncodeFunc func(name string, registrationID types.UUID) *hazelcast.ClientMessage
+type ItemListenerHandleFunc func(msg *hazelcast.ClientMessage, handler func(item hazelcast.Data, uuid types.UUID, eventType int32))
+
+// ItemListenerCodecs contains the codecs to add and remove an item listener of a collection.
+type ItemListenerCodecs struct {
+	EncodeAdd    ItemListenerAddEncodeFunc
+	EncodeRemove ItemListenerRemoveEncodeFunc
+	Handle       ItemListenerHandleFunc
+}
+
+type ItemListenCommand struct {
+	typeName   string
+	metricName string
+	codecs     ItemListenerCodecs
+}
+
+func NewItemListenCommand(typeName, metricName string, codecs ItemListenerCodecs) *ItemListenCommand {
+	return &ItemListenCommand{
+		typeName:   typeName,
+		metricName: metricName,
+		codecs:     codecs,
+	}
+}
+
+func (cm ItemListenCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("listen")
+	long := fmt.Sprintf(`Listen to the item events of the given %s
+
+An event is printed when an element is added to or removed from the %s.
+Press Ctrl+C to stop listening.`, cm.typeName, cm.typeName)
+	short := fmt.Sprintf("Listen to the item events of the given %s", cm.typeName)
+	cc.SetCommandHelp(long, short)
+	cc.AddIntFlag(flagItemListenerCount, "", 0, false, "number of events to receive")
+	return nil
+}
+
+func (cm ItemListenCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	count := int(ec.Props().GetInt(flagItemListenerCount))
+	if count < 0 {
+		return fmt.Errorf("%s cannot be negative", flagItemListenerCount)
+	}
+	ctx, stopSignal := signal.NotifyContext(ctx, os.Interrupt, os.Kill)
+	defer stopSignal()
+	// Channel is not closed intentionally
+	rowCh := make(chan output.Row)
+	var ci *hazelcast.ClientInternal
+	sid, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (types.UUID, error) {
+		var err error
+		ci, err = cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return types.UUID{}, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total."+cm.metricName)
+		sp.SetText(fmt.Sprintf("Listening to events of %s '%s'", cm.typeName, name))
+		return cm.addListener(ctx, ci, name, func(item hazelcast.Data, eventType int32) {
+			row := makeItemEventRow(ec, ci, eventType, item)
+			select {
+			case rowCh <- row:
+			case <-ctx.Done():
+			}
+		})
+	})
+	if err != nil {
+		return err
+	}
+	defer ci.ListenerBinder().Remove(context.Background(), sid)
+	defer stop()
+	ec.PrintlnUnnecessary(fmt.Sprintf("Listening to events of %s '%s'", cm.typeName, name))
+	outCh := make(chan output.Row)
+	go func() {
+		defer close(outCh)
+		for i := 0; count == 0 || i < count; i++ {
+			select {
+			case row := <-rowCh:
+				select {
+				case outCh <- row:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ec.AddOutputStream(ctx, outCh)
+}
+
+// addListener adds an item listener to the given collection and returns its subscription ID.
+func (cm ItemListenCommand) addListener(ctx context.Context, ci *hazelcast.ClientInternal, name string, handler func(item hazelcast.Data, eventType int32)) (types.UUID, error) {
+	sid := types.NewUUID()
+	addReq := cm.codecs.EncodeAdd(name, true, false)
+	removeReq := cm.codecs.EncodeRemove(name, sid)
+	listenerHandler := func(msg *hazelcast.ClientMessage) {
+		cm.codecs.Handle(msg, func(item hazelcast.Data, uuid types.UUID, eventType int32) {
+			handler(item, eventType)
+		})
+	}
+	if err := ci.ListenerBinder().Add(ctx, sid, addReq, removeReq, listenerHandler); err != nil {
+		return types.UUID{}, err
+	}
+	return sid, nil
+}
+
+func makeItemEventRow(ec plug.ExecContext, ci *hazelcast.ClientInternal, eventType int32, item hazelcast.Data) output.Row {
+	vt := item.Type()
+	value, err := ci.DecodeData(item)
+	if err != nil {
+		ec.Logger().Info("The value was not decoded, due to error: %s", err.Error())
+		value = serialization.NondecodedValue(item, ec.Props().GetBool(base.FlagRaw))
+	}
+	row := output.Row{
+		output.Column{
+			Name:  "Event",
+			Type:  serialization.TypeString,
+			Value: itemEventTypeLabel(eventType),
+		},
+		output.Column{
+			Name:  output.NameValue,
+			Type:  vt,
+			Value: value,
+		},
+	}
+	if ec.Props().GetBool(base.FlagShowType) {
+		row = append(row, output.Column{
+			Name:  output.NameValueType,
+			Type:  serialization.TypeString,
+			Value: serialization.TypeToLabel(vt),
+		})
+	}
+	return row
+}
+
+func itemEventTypeLabel(eventType int32) string {
+	switch eventType {
+	case itemEventAdded:
+		return "ADDED"
+	case itemEventRemoved:
+		return "REMOVED"
+	}
+	return fmt.Sprintf("UNKNOWN (%d)", eventType)
+}
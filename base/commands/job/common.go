@@ -12,6 +12,7 @@ import (
 
 	"github.com/hazelcast/hazelcast-go-client/types"
 
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
 	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
 	"github.com/hazelcast/hazelcast-commandline-client/clc/ux/stage"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/jet"
@@ -45,17 +46,7 @@ func WaitJobState(ctx context.Context, ec plug.ExecContext, sp stage.Statuser[in
 }
 
 func idToString(id int64) string {
-	buf := []byte("0000-0000-0000-0000")
-	hex := []byte(strconv.FormatInt(id, 16))
-	j := 18
-	for i := len(hex) - 1; i >= 0; i-- {
-		buf[j] = hex[i]
-		if j == 15 || j == 10 || j == 5 {
-			j--
-		}
-		j--
-	}
-	return string(buf[:])
+	return jet.IDToString(id)
 }
 
 func terminateJob(ctx context.Context, ec plug.ExecContext, tm int32, cm TerminateCommand) error {
@@ -137,6 +128,29 @@ func MakeJobNameIDMaps(jobList []control.JobAndSqlSummary) (jobNameToID map[stri
 	return jobNameToID, jobIDToInfo
 }
 
+// completeJobs suggests the names and IDs of the jobs which are not completed or failed.
+func completeJobs(ctx context.Context, ec plug.ExecContext) ([]string, error) {
+	jl, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) ([]control.JobAndSqlSummary, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		return jet.New(ci, sp, ec.Logger()).GetJobList(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	stop()
+	var ss []string
+	for _, j := range jl {
+		if j.LightJob || j.Status == jet.JobStatusFailed || j.Status == jet.JobStatusCompleted {
+			continue
+		}
+		ss = append(ss, j.NameOrId, idToString(j.JobId))
+	}
+	return ss, nil
+}
+
 type JobsInfo struct {
 	nameToID map[string]int64
 	IDToInfo map[int64]control.JobAndSqlSummary
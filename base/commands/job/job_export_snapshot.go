@@ -27,6 +27,7 @@ func (ExportSnapshotCommand) Init(cc plug.InitContext) error {
 	cc.AddStringFlag(flagName, "", "", false, "specify the snapshot. By default an auto-genertaed snapshot name is used")
 	cc.AddBoolFlag(flagCancel, "", false, false, "cancel the job after taking the snapshot")
 	cc.AddStringArg(argJobID, argTitleJobID)
+	cc.SetArgCompletion(completeJobs)
 	return nil
 }
 
@@ -22,6 +22,7 @@ func (ResumeCommand) Init(cc plug.InitContext) error {
 	cc.SetCommandHelp(help, help)
 	cc.AddBoolFlag(flagWait, "", false, false, "wait for the job to be resumed")
 	cc.AddStringArg(argJobID, argTitleJobID)
+	cc.SetArgCompletion(completeJobs)
 	return nil
 }
 
@@ -42,6 +42,7 @@ This command requires a Viridian or a Hazelcast cluster having version %s or new
 	cc.SetCommandHelp(long, short)
 	cc.AddStringFlag(flagName, "", "", false, "override the job name")
 	cc.AddStringFlag(flagSnapshot, "", "", false, "initial snapshot to start the job from")
+	cc.SetFlagCompletion(flagSnapshot, jet.CompleteSnapshots)
 	cc.AddStringFlag(flagClass, "", "", false, "the class that contains the main method that creates the Jet job")
 	cc.AddIntFlag(flagRetries, "", 0, false, "number of times to retry a failed upload attempt")
 	cc.AddBoolFlag(flagWait, "", false, false, "wait for the job to be started")
@@ -29,6 +29,7 @@ func (cm TerminateCommand) Init(cc plug.InitContext) error {
 	cc.AddBoolFlag(flagForce, "", false, false, fmt.Sprintf("force %s the job", cm.name))
 	cc.AddBoolFlag(flagWait, "", false, false, "wait for the operation to finish")
 	cc.AddStringArg(argJobID, argTitleJobID)
+	cc.SetArgCompletion(completeJobs)
 	return nil
 }
 
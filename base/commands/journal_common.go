@@ -0,0 +1,462 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+const (
+	flagJournalFromSequence = "from-sequence"
+	flagJournalCount        = "count"
+	flagJournalFollow       = "follow"
+	flagJournalPredicate    = "predicate"
+	flagJournalProjection   = "projection"
+	journalSequenceOldest   = "oldest"
+	journalSequenceNewest   = "newest"
+	journalReadBatchSize    = 100
+	journalPollInterval     = 500 * time.Millisecond
+)
+
+const (
+	journalFieldPartition = "partition"
+	journalFieldSequence  = "sequence"
+	journalFieldType      = "type"
+	journalFieldKey       = "key"
+	journalFieldValue     = "value"
+	journalFieldOldValue  = "old-value"
+)
+
+var journalFields = []string{
+	journalFieldPartition,
+	journalFieldSequence,
+	journalFieldType,
+	journalFieldKey,
+	journalFieldValue,
+	journalFieldOldValue,
+}
+
+type JournalSubscribeEncodeFunc func(name string) *hazelcast.ClientMessage
+type JournalSubscribeDecodeFunc func(msg *hazelcast.ClientMessage) (oldestSequence, newestSequence int64)
+type JournalReadEncodeFunc func(name string, startSequence int64, minSize, maxSize int32, predicate, projection hazelcast.Data) *hazelcast.ClientMessage
+type JournalReadDecodeFunc func(msg *hazelcast.ClientMessage) (readCount int32, nextSeq int64, items []*hazelcast.Data, itemSeqs []int64)
+
+// JournalCodecs contains the codecs to subscribe to and read from an event journal.
+type JournalCodecs struct {
+	EncodeSubscribe JournalSubscribeEncodeFunc
+	DecodeSubscribe JournalSubscribeDecodeFunc
+	EncodeRead      JournalReadEncodeFunc
+	DecodeRead      JournalReadDecodeFunc
+}
+
+// journalNameFunc returns the name of the data structure as it is known by the cluster.
+type journalNameFunc func(ctx context.Context, ec plug.ExecContext, sp clc.Spinner) (string, error)
+
+type JournalCommand struct {
+	typeName    string
+	metricName  string
+	codecs      JournalCodecs
+	nameFn      journalNameFunc
+	eventTypeFn func(eventType int32) string
+}
+
+func NewJournalCommand(typeName, metricName string, codecs JournalCodecs, nameFn journalNameFunc, eventTypeFn func(int32) string) *JournalCommand {
+	return &JournalCommand{
+		typeName:    typeName,
+		metricName:  metricName,
+		codecs:      codecs,
+		nameFn:      nameFn,
+		eventTypeFn: eventTypeFn,
+	}
+}
+
+func (cm JournalCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("journal")
+	long := fmt.Sprintf(`Read the event journal of the given %s
+
+The event journal must be enabled for the %s on the cluster.
+Events are output in the order of partitions, and in the order of sequence numbers in each partition.
+
+The predicate filters the events by one or more comma separated conditions, all of which must match.
+A condition has the form FIELD=VALUE or FIELD!=VALUE, where FIELD is one of: type, key, value, old-value.
+E.g., --predicate 'type=ADDED,key=foo'
+
+The projection is the comma separated list of fields to output.
+The fields are: %s.
+E.g., --projection 'sequence,key,value'
+
+The member accepts only Java functions as the predicate and the projection of an event journal read,
+so the predicate and the projection are applied by CLC after reading the events.
+If the predicate has a key=VALUE condition and --key-type is given,
+only the partition which owns the key is read.`, cm.typeName, cm.typeName, strings.Join(journalFields, ", "))
+	short := fmt.Sprintf("Read the event journal of the given %s", cm.typeName)
+	cc.SetCommandHelp(long, short)
+	cc.AddStringFlag(flagJournalFromSequence, "", journalSequenceOldest, false, "start reading from: oldest, newest or the sequence number in each partition")
+	cc.AddIntFlag(flagJournalCount, "", 0, false, "number of events to output; 0 outputs all events")
+	cc.AddBoolFlag(flagJournalFollow, "", false, false, "wait for new events after reading the existing ones")
+	cc.AddStringFlag(flagJournalPredicate, "", "", false, "filter the events, e.g., type=ADDED,key=foo")
+	cc.AddStringFlag(flagJournalProjection, "", "", false, "fields to output, e.g., sequence,key,value")
+	keyTypeHelp := fmt.Sprintf("type of the key in the key=VALUE condition of the predicate (one of: %s, %sTYPE, %sFACTORY:CLASS)", strings.Join(internal.SupportedTypeNames, ", "), internal.TypeNamePrefixCompact, internal.TypeNamePrefixPortable)
+	cc.AddStringFlag(base.FlagKeyType, "k", "", false, keyTypeHelp)
+	return nil
+}
+
+func (cm JournalCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	from := ec.Props().GetString(flagJournalFromSequence)
+	startSeq, err := parseJournalSequence(from)
+	if err != nil {
+		return err
+	}
+	conds, err := parseJournalPredicate(ec.Props().GetString(flagJournalPredicate))
+	if err != nil {
+		return err
+	}
+	fields, err := parseJournalProjection(ec.Props().GetString(flagJournalProjection))
+	if err != nil {
+		return err
+	}
+	rv, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (*journalReader, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total."+cm.metricName)
+		objName, err := cm.nameFn(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		pids, err := journalPartitions(ctx, ec, ci, conds)
+		if err != nil {
+			return nil, err
+		}
+		sp.SetText(fmt.Sprintf("Subscribing to the event journal of %s '%s'", cm.typeName, name))
+		pc := ci.PartitionCount()
+		r := &journalReader{
+			ci:      ci,
+			name:    objName,
+			codecs:  cm.codecs,
+			pids:    pids,
+			next:    make([]int64, pc),
+			newest:  make([]int64, pc),
+			follow:  ec.Props().GetBool(flagJournalFollow),
+			wanted:  int(ec.Props().GetInt(flagJournalCount)),
+			decoder: cm.newEventDecoder(ec, ci, conds, fields),
+		}
+		for _, pid := range pids {
+			req := cm.codecs.EncodeSubscribe(objName)
+			resp, err := ci.InvokeOnPartition(ctx, req, pid, nil)
+			if err != nil {
+				return nil, err
+			}
+			oldest, newest := cm.codecs.DecodeSubscribe(resp)
+			r.newest[pid] = newest
+			switch startSeq {
+			case journalStartOldest:
+				r.next[pid] = oldest
+			case journalStartNewest:
+				r.next[pid] = newest + 1
+			default:
+				// cannot start before the oldest event in the journal
+				r.next[pid] = max(startSeq, oldest)
+			}
+		}
+		return r, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	ctx, stopSignal := signal.NotifyContext(ctx, os.Interrupt, os.Kill)
+	defer stopSignal()
+	if rv.follow {
+		ec.PrintlnUnnecessary(fmt.Sprintf("Following the event journal of %s '%s'", cm.typeName, name))
+	}
+	rowCh := make(chan output.Row)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- rv.Read(ctx, rowCh)
+		close(rowCh)
+	}()
+	if err := ec.AddOutputStream(ctx, rowCh); err != nil {
+		return err
+	}
+	select {
+	case err := <-errCh:
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		return err
+	default:
+		return nil
+	}
+}
+
+const (
+	journalStartOldest int64 = -1
+	journalStartNewest int64 = -2
+)
+
+func parseJournalSequence(s string) (int64, error) {
+	switch strings.ToLower(s) {
+	case journalSequenceOldest, "":
+		return journalStartOldest, nil
+	case journalSequenceNewest:
+		return journalStartNewest, nil
+	}
+	seq, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, fmt.Errorf("invalid sequence: %s (should be oldest, newest or a non-negative number)", s)
+	}
+	return seq, nil
+}
+
+type journalCondition struct {
+	field  string
+	value  string
+	negate bool
+}
+
+func parseJournalPredicate(s string) ([]journalCondition, error) {
+	var conds []journalCondition
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		var c journalCondition
+		field, value, ok := strings.Cut(item, "!=")
+		if ok {
+			c.negate = true
+		} else if field, value, ok = strings.Cut(item, "="); !ok {
+			return nil, fmt.Errorf("invalid condition: %s (should be FIELD=VALUE or FIELD!=VALUE)", item)
+		}
+		c.field = strings.TrimSpace(field)
+		c.value = strings.TrimSpace(value)
+		switch c.field {
+		case journalFieldType, journalFieldKey, journalFieldValue, journalFieldOldValue:
+		default:
+			return nil, fmt.Errorf("invalid predicate field: %s (should be one of: type, key, value, old-value)", c.field)
+		}
+		conds = append(conds, c)
+	}
+	return conds, nil
+}
+
+func parseJournalProjection(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return journalFields, nil
+	}
+	var fields []string
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		if !isJournalField(f) {
+			return nil, fmt.Errorf("invalid projection field: %s (should be one of: %s)", f, strings.Join(journalFields, ", "))
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// journalPartitions returns the IDs of the partitions to read.
+// Only the partition which owns the key is read if the key type is given and one of the conditions requires a key,
+// since the events of a key are in the journal of that partition.
+func journalPartitions(ctx context.Context, ec plug.ExecContext, ci *hazelcast.ClientInternal, conds []journalCondition) ([]int32, error) {
+	if ec.Props().GetString(base.FlagKeyType) != "" {
+		for _, c := range conds {
+			if c.field != journalFieldKey || c.negate {
+				continue
+			}
+			kd, err := MakeKeyData(ctx, ec, ci, c.value)
+			if err != nil {
+				return nil, fmt.Errorf("converting the key in the predicate: %w", err)
+			}
+			pid, err := ci.GetPartitionID(kd)
+			if err != nil {
+				return nil, err
+			}
+			return []int32{pid}, nil
+		}
+	}
+	pids := make([]int32, ci.PartitionCount())
+	for i := range pids {
+		pids[i] = int32(i)
+	}
+	return pids, nil
+}
+
+func isJournalField(f string) bool {
+	for _, jf := range journalFields {
+		if f == jf {
+			return true
+		}
+	}
+	return false
+}
+
+type journalEventDecoderFunc func(partitionID int32, seq int64, data hazelcast.Data) (output.Row, bool)
+
+func (cm JournalCommand) newEventDecoder(ec plug.ExecContext, ci *hazelcast.ClientInternal, conds []journalCondition, fields []string) journalEventDecoderFunc {
+	showType := ec.Props().GetBool(base.FlagShowType)
+	raw := ec.Props().GetBool(base.FlagRaw)
+	decode := func(b []byte) (int32, any) {
+		if b == nil {
+			return serialization.TypeNil, nil
+		}
+		d := hazelcast.Data(b)
+		t := d.Type()
+		v, err := ci.DecodeData(d)
+		if err != nil {
+			ec.Logger().Info("The value was not decoded, due to error: %s", err.Error())
+			v = serialization.NondecodedValue(d, raw)
+		}
+		return t, v
+	}
+	return func(partitionID int32, seq int64, data hazelcast.Data) (output.Row, bool) {
+		ev, err := serialization.DecodeJournalEvent(data)
+		if err != nil {
+			ec.Logger().Error(fmt.Errorf("decoding event journal event: %w", err))
+			return nil, false
+		}
+		evType := cm.eventTypeFn(ev.EventType)
+		kt, key := decode(ev.Key)
+		vt, value := decode(ev.NewValue)
+		ot, oldValue := decode(ev.OldValue)
+		for _, c := range conds {
+			var s string
+			switch c.field {
+			case journalFieldType:
+				s = evType
+			case journalFieldKey:
+				s = fmt.Sprint(key)
+			case journalFieldValue:
+				s = fmt.Sprint(value)
+			case journalFieldOldValue:
+				s = fmt.Sprint(oldValue)
+			}
+			matched := s == c.value
+			if c.field == journalFieldType {
+				matched = strings.EqualFold(s, c.value)
+			}
+			if matched == c.negate {
+				return nil, false
+			}
+		}
+		row := make(output.Row, 0, len(fields))
+		for _, f := range fields {
+			switch f {
+			case journalFieldPartition:
+				row = append(row, output.Column{Name: "Partition", Type: serialization.TypeInt32, Value: partitionID})
+			case journalFieldSequence:
+				row = append(row, output.Column{Name: "Sequence", Type: serialization.TypeInt64, Value: seq})
+			case journalFieldType:
+				row = append(row, output.Column{Name: "Event", Type: serialization.TypeString, Value: evType})
+			case journalFieldKey:
+				row = append(row, output.NewKeyColumn(kt, key))
+				if showType {
+					row = append(row, output.NewKeyTypeColumn(kt))
+				}
+			case journalFieldValue:
+				row = append(row, output.NewValueColumn(vt, value))
+				if showType {
+					row = append(row, output.NewValueTypeColumn(vt))
+				}
+			case journalFieldOldValue:
+				row = append(row, output.Column{Name: "Old Value", Type: ot, Value: oldValue})
+				if showType {
+					row = append(row, output.Column{Name: "Old Value Type", Type: serialization.TypeString, Value: serialization.TypeToLabel(ot)})
+				}
+			}
+		}
+		return row, true
+	}
+}
+
+type journalReader struct {
+	ci      *hazelcast.ClientInternal
+	name    string
+	codecs  JournalCodecs
+	pids    []int32
+	next    []int64
+	newest  []int64
+	follow  bool
+	wanted  int
+	decoder journalEventDecoderFunc
+}
+
+// Read reads the events from all partitions and sends the output rows to the given channel.
+// If following is not enabled, it returns after reading the events which existed when the journal was subscribed.
+func (r *journalReader) Read(ctx context.Context, rowCh chan<- output.Row) error {
+	done := make([]bool, len(r.next))
+	printed := 0
+	for {
+		var readAny, pending bool
+		for _, pid := range r.pids {
+			if done[pid] {
+				continue
+			}
+			req := r.codecs.EncodeRead(r.name, r.next[pid], 0, journalReadBatchSize, nil, nil)
+			resp, err := r.ci.InvokeOnPartition(ctx, req, pid, nil)
+			if err != nil {
+				return err
+			}
+			readCount, nextSeq, items, itemSeqs := r.codecs.DecodeRead(resp)
+			for i, item := range items {
+				seq := r.next[pid] + int64(i)
+				if i < len(itemSeqs) {
+					seq = itemSeqs[i]
+				}
+				row, ok := r.decoder(pid, seq, *item)
+				if !ok {
+					continue
+				}
+				select {
+				case rowCh <- row:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+				printed++
+				if r.wanted > 0 && printed >= r.wanted {
+					return nil
+				}
+			}
+			r.next[pid] = nextSeq
+			if readCount > 0 {
+				readAny = true
+			}
+			if !r.follow && (readCount == 0 || nextSeq > r.newest[pid]) {
+				done[pid] = true
+			} else {
+				pending = true
+			}
+		}
+		if !pending {
+			return nil
+		}
+		if !readAny {
+			select {
+			case <-time.After(journalPollInterval):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
@@ -5,6 +5,7 @@ package list
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/hazelcast/hazelcast-go-client"
 
@@ -46,7 +47,7 @@ func removeFromList(ctx context.Context, ec plug.ExecContext, name string, index
 		if indexCall {
 			req = codec.EncodeListRemoveWithIndexRequest(name, index)
 		} else {
-			vd, err := commands.MakeValueData(ec, ci, valueStr)
+			vd, err := commands.MakeValueData(ctx, ec, ci, valueStr)
 			if err != nil {
 				return nil, err
 			}
@@ -56,35 +57,21 @@ func removeFromList(ctx context.Context, ec plug.ExecContext, name string, index
 		if err != nil {
 			return nil, err
 		}
-		var vt int32
-		var value any
-		var colName string
 		if indexCall {
-			raw := codec.DecodeListRemoveWithIndexResponse(resp)
-			vt = raw.Type()
-			value, err = ci.DecodeData(raw)
-			colName = "Removed Value"
-			if err != nil {
-				ec.Logger().Info("The value was not decoded, due to error: %s", err.Error())
-				value = serialization.NondecodedType(serialization.TypeToLabel(vt))
-			}
-		} else {
-			vt = serialization.TypeBool
-			value = codec.DecodeListRemoveResponse(resp)
-			colName = "Removed"
+			return convertDataToRow(ec, ci, "Removed Value", codec.DecodeListRemoveWithIndexResponse(resp)), nil
 		}
 		row := output.Row{
 			output.Column{
-				Name:  colName,
-				Type:  vt,
-				Value: value,
+				Name:  "Removed",
+				Type:  serialization.TypeBool,
+				Value: codec.DecodeListRemoveResponse(resp),
 			},
 		}
 		if ec.Props().GetBool(base.FlagShowType) {
 			row = append(row, output.Column{
 				Name:  output.NameValueType,
 				Type:  serialization.TypeString,
-				Value: serialization.TypeToLabel(vt),
+				Value: serialization.TypeToLabel(serialization.TypeBool),
 			})
 		}
 		return row, nil
@@ -99,11 +86,14 @@ func removeFromList(ctx context.Context, ec plug.ExecContext, name string, index
 	return ec.AddOutputRows(ctx, row)
 }
 
-func convertDataToRow(ci *hazelcast.ClientInternal, name string, data hazelcast.Data, showType bool) (output.Row, error) {
+// convertDataToRow returns the row for the given value.
+// If the value cannot be decoded, a placeholder is used instead of it, or its raw form if --raw is given.
+func convertDataToRow(ec plug.ExecContext, ci *hazelcast.ClientInternal, name string, data hazelcast.Data) output.Row {
 	vt := data.Type()
 	value, err := ci.DecodeData(data)
 	if err != nil {
-		return nil, err
+		ec.Logger().Info("The value was not decoded, due to error: %s", err.Error())
+		value = serialization.NondecodedValue(data, ec.Props().GetBool(base.FlagRaw))
 	}
 	row := output.Row{
 		output.Column{
@@ -112,12 +102,49 @@ func convertDataToRow(ci *hazelcast.ClientInternal, name string, data hazelcast.
 			Value: value,
 		},
 	}
-	if showType {
+	if ec.Props().GetBool(base.FlagShowType) {
 		row = append(row, output.Column{
 			Name:  output.NameValueType,
 			Type:  serialization.TypeString,
 			Value: serialization.TypeToLabel(vt),
 		})
 	}
-	return row, nil
+	return row
+}
+
+// invokeOnList sends the request to the partition which owns the list.
+// The list proxy is created before sending the request.
+func invokeOnList(ctx context.Context, ec plug.ExecContext, sp clc.Spinner, req *hazelcast.ClientMessage) (*hazelcast.ClientMessage, error) {
+	name := ec.Props().GetString(base.FlagName)
+	ci, err := cmd.ClientInternal(ctx, ec, sp)
+	if err != nil {
+		return nil, err
+	}
+	// get the list just to ensure the corresponding proxy is created
+	if _, err = getList(ctx, ec, sp); err != nil {
+		return nil, err
+	}
+	pid, err := internal.StringToPartitionID(ci, name)
+	if err != nil {
+		return nil, err
+	}
+	return ci.InvokeOnPartition(ctx, req, pid, nil)
+}
+
+func convertDataSliceToRows(ec plug.ExecContext, ci *hazelcast.ClientInternal, items []*hazelcast.Data) []output.Row {
+	rows := make([]output.Row, len(items))
+	for i, item := range items {
+		rows[i] = convertDataToRow(ec, ci, output.NameValue, *item)
+	}
+	return rows
+}
+
+func validateIndex(name string, index int64) error {
+	if index < 0 {
+		return fmt.Errorf("%s must be non-negative", name)
+	}
+	if index > math.MaxInt32 {
+		return fmt.Errorf("%s must fit into a 32bit unsigned integer", name)
+	}
+	return nil
 }
@@ -4,6 +4,15 @@ package list
 
 const (
 	flagIndex     = "index"
+	flagFrom      = "from"
+	flagCount     = "count"
+	flagPageSize  = "page-size"
 	argIndex      = "index"
 	argTitleIndex = "index"
+	argFrom       = "from"
+	argTitleFrom  = "from index"
+	argTo         = "to"
+	argTitleTo    = "to index"
+	// defaultPageSize is the default number of elements fetched from the cluster at once while iterating.
+	defaultPageSize = 100
 )
@@ -46,7 +46,7 @@ func (AddCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 			return nil, err
 		}
 		valueStr := ec.GetStringArg(base.ArgValue)
-		vd, err := commands.MakeValueData(ec, ci, valueStr)
+		vd, err := commands.MakeValueData(ctx, ec, ci, valueStr)
 		if err != nil {
 			return nil, err
 		}
@@ -43,7 +43,7 @@ func (mc *ListContainsCommand) Exec(ctx context.Context, ec plug.ExecContext) er
 			return nil, err
 		}
 		valueStr := ec.GetStringArg(base.ArgValue)
-		vd, err := commands.MakeValueData(ec, ci, valueStr)
+		vd, err := commands.MakeValueData(ctx, ec, ci, valueStr)
 		if err != nil {
 			return nil, err
 		}
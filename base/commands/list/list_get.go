@@ -0,0 +1,59 @@
+//go:build std || list
+
+package list
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type ListGetCommand struct{}
+
+func (mc *ListGetCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("get")
+	help := "Return the value at the given index in the list"
+	cc.SetCommandHelp(help, help)
+	commands.AddValueTypeFlag(cc)
+	cc.AddInt64Arg(argIndex, argTitleIndex)
+	return nil
+}
+
+func (mc *ListGetCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	index := ec.GetInt64Arg(argIndex)
+	if err := validateIndex(argIndex, index); err != nil {
+		return err
+	}
+	row, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (output.Row, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.list")
+		sp.SetText(fmt.Sprintf("Getting the value from List '%s'", name))
+		resp, err := invokeOnList(ctx, ec, sp, codec.EncodeListGetRequest(name, int32(index)))
+		if err != nil {
+			return nil, err
+		}
+		data := codec.DecodeListGetResponse(resp)
+		return convertDataToRow(ec, ci, output.NameValue, data), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return ec.AddOutputRows(ctx, row)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("list:get", &ListGetCommand{}))
+}
@@ -0,0 +1,88 @@
+//go:build std || list
+
+package list
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+type ListIndexOfCommand struct {
+	last bool
+}
+
+func (mc *ListIndexOfCommand) Init(cc plug.InitContext) error {
+	which := "first"
+	if mc.last {
+		cc.SetCommandUsage("last-index-of")
+		which = "last"
+	} else {
+		cc.SetCommandUsage("index-of")
+	}
+	long := fmt.Sprintf(`Return the index of the %s occurrence of the value in the list
+
+Returns -1 if the list does not contain the value.`, which)
+	short := fmt.Sprintf("Return the index of the %s occurrence of the value in the list", which)
+	cc.SetCommandHelp(long, short)
+	commands.AddValueTypeFlag(cc)
+	cc.AddStringArg(base.ArgValue, base.ArgTitleValue)
+	return nil
+}
+
+func (mc *ListIndexOfCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	index, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (int32, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return 0, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.list")
+		vd, err := commands.MakeValueData(ctx, ec, ci, ec.GetStringArg(base.ArgValue))
+		if err != nil {
+			return 0, err
+		}
+		sp.SetText(fmt.Sprintf("Finding the value in List '%s'", name))
+		var req *hazelcast.ClientMessage
+		if mc.last {
+			req = codec.EncodeListLastIndexOfRequest(name, vd)
+		} else {
+			req = codec.EncodeListIndexOfRequest(name, vd)
+		}
+		resp, err := invokeOnList(ctx, ec, sp, req)
+		if err != nil {
+			return 0, err
+		}
+		if mc.last {
+			return codec.DecodeListLastIndexOfResponse(resp), nil
+		}
+		return codec.DecodeListIndexOfResponse(resp), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return ec.AddOutputRows(ctx, output.Row{
+		{
+			Name:  "Index",
+			Type:  serialization.TypeInt32,
+			Value: index,
+		},
+	})
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("list:index-of", &ListIndexOfCommand{}))
+	check.Must(plug.Registry.RegisterCommand("list:last-index-of", &ListIndexOfCommand{last: true}))
+}
@@ -6,8 +6,10 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
 	"github.com/hazelcast/hazelcast-go-client/types"
 	"github.com/stretchr/testify/require"
 
@@ -25,11 +27,17 @@ func TestList(t *testing.T) {
 		{name: "Add_WithIndex_NonInteractive", f: add_WithIndex_NonInteractiveTest},
 		{name: "Clear_NonInteractive", f: clear_NonInteractiveTest},
 		{name: "Contains_NonInteractive", f: contains_NonInteractiveTest},
+		{name: "Get_NonInteractive", f: get_NonInteractiveTest},
+		{name: "IndexOf_NonInteractive", f: indexOf_NonInteractiveTest},
+		{name: "Iterate_NonInteractive", f: iterate_NonInteractiveTest},
+		{name: "Listen_NonInteractive", f: listen_NonInteractiveTest},
 		{name: "RemoveIndex_Noninteractive", f: removeIndex_NonInteractiveTest},
 		{name: "Remove_Noninteractive", f: remove_NonInteractiveTest},
 		{name: "Set_NonInteractive", f: set_NonInteractiveTest},
 		{name: "Size_Interactive", f: size_InteractiveTest},
 		{name: "Size_Noninteractive", f: size_NoninteractiveTest},
+		{name: "SubList_NonInteractive", f: subList_NonInteractiveTest},
+		{name: "Undecodable_NonInteractive", f: undecodable_NonInteractiveTest},
 		{name: "Destroy_NonInteractive", f: destroy_NonInteractiveTest},
 		{name: "Destroy_AutoYes_NonInteractiveTest", f: destroy_autoYes_NonInteractiveTest},
 	}
@@ -94,6 +102,67 @@ func contains_NonInteractiveTest(t *testing.T) {
 	})
 }
 
+func get_NonInteractiveTest(t *testing.T) {
+	it.ListTester(t, func(tcx it.TestContext, l *hz.List) {
+		ctx := context.Background()
+		check.MustValue(l.AddAll(ctx, "foo", "bar"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "list", "-n", l.Name(), "get", "1", "-q", "--show-type"))
+			tcx.AssertStdoutEquals("bar\tSTRING\n")
+		})
+	})
+}
+
+func indexOf_NonInteractiveTest(t *testing.T) {
+	it.ListTester(t, func(tcx it.TestContext, l *hz.List) {
+		ctx := context.Background()
+		check.MustValue(l.AddAll(ctx, "foo", "bar", "foo"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "list", "-n", l.Name(), "index-of", "foo", "-q"))
+			tcx.AssertStdoutEquals("0\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "list", "-n", l.Name(), "last-index-of", "foo", "-q"))
+			tcx.AssertStdoutEquals("2\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "list", "-n", l.Name(), "index-of", "baz", "-q"))
+			tcx.AssertStdoutEquals("-1\n")
+		})
+	})
+}
+
+func iterate_NonInteractiveTest(t *testing.T) {
+	it.ListTester(t, func(tcx it.TestContext, l *hz.List) {
+		ctx := context.Background()
+		check.MustValue(l.AddAll(ctx, "v0", "v1", "v2", "v3", "v4"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "list", "-n", l.Name(), "iterate", "--page-size", "2", "-q"))
+			tcx.AssertStdoutEquals("v0\nv1\nv2\nv3\nv4\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "list", "-n", l.Name(), "iterate", "--page-size", "2", "--from", "1", "--count", "3", "-q"))
+			tcx.AssertStdoutEquals("v1\nv2\nv3\n")
+		})
+	})
+}
+
+func listen_NonInteractiveTest(t *testing.T) {
+	it.ListTester(t, func(tcx it.TestContext, l *hz.List) {
+		ctx := context.Background()
+		tcx.WithReset(func() {
+			go func() {
+				check.Must(tcx.CLC().Execute(ctx, "list", "-n", l.Name(), "listen", "--count", "2"))
+			}()
+			time.Sleep(1 * time.Second)
+			check.MustValue(l.Add(ctx, "foo"))
+			check.MustValue(l.Remove(ctx, "foo"))
+			tcx.AssertStdoutContains("ADDED")
+			tcx.AssertStdoutContains("REMOVED")
+		})
+	})
+}
+
 func remove_NonInteractiveTest(t *testing.T) {
 	it.ListTester(t, func(tcx it.TestContext, l *hz.List) {
 		ctx := context.Background()
@@ -166,6 +235,17 @@ func size_InteractiveTest(t *testing.T) {
 	})
 }
 
+func subList_NonInteractiveTest(t *testing.T) {
+	it.ListTester(t, func(tcx it.TestContext, l *hz.List) {
+		ctx := context.Background()
+		check.MustValue(l.AddAll(ctx, "v0", "v1", "v2", "v3"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "list", "-n", l.Name(), "sub-list", "1", "3", "-q"))
+			tcx.AssertStdoutEquals("v1\nv2\n")
+		})
+	})
+}
+
 func destroy_NonInteractiveTest(t *testing.T) {
 	it.ListTester(t, func(tcx it.TestContext, l *hz.List) {
 		t := tcx.T
@@ -199,3 +279,34 @@ func objectExists(sn, name string, objects []types.DistributedObjectInfo) bool {
 	}
 	return false
 }
+
+func undecodable_NonInteractiveTest(t *testing.T) {
+	it.ListTester(t, func(tcx it.TestContext, l *hz.List) {
+		ctx := context.Background()
+		check.MustValue(l.AddAll(ctx, "v0", undecodable{}, "v2"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "list", "-n", l.Name(), "get", "1", "-q"))
+			tcx.AssertStdoutEquals("?\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "list", "-n", l.Name(), "sub-list", "0", "3", "-q"))
+			tcx.AssertStdoutEquals("v0\n?\nv2\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "list", "-n", l.Name(), "iterate", "--page-size", "2", "-q"))
+			tcx.AssertStdoutEquals("v0\n?\nv2\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "list", "-n", l.Name(), "set", "1", "v1", "-q"))
+			tcx.AssertStdoutEquals("?\n")
+		})
+	})
+}
+
+// undecodable is an IdentifiedDataSerializable value whose factory is not registered in CLC.
+type undecodable struct{}
+
+func (undecodable) FactoryID() int32                       { return 9999 }
+func (undecodable) ClassID() int32                         { return 1 }
+func (undecodable) WriteData(out serialization.DataOutput) {}
+func (undecodable) ReadData(in serialization.DataInput)    {}
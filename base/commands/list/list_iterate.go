@@ -0,0 +1,152 @@
+//go:build std || list
+
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/hazelcast/hazelcast-go-client"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type ListIterateCommand struct{}
+
+func (mc *ListIterateCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("iterate")
+	long := `Iterate over the values in the list
+
+The values are fetched from the cluster in pages, so the whole list is not loaded into the memory at once.`
+	short := "Iterate over the values in the list"
+	cc.SetCommandHelp(long, short)
+	commands.AddValueTypeFlag(cc)
+	cc.AddIntFlag(flagFrom, "", 0, false, "index of the first value")
+	cc.AddIntFlag(flagCount, "", 0, false, "maximum number of values to output; 0 outputs all values")
+	cc.AddIntFlag(flagPageSize, "", defaultPageSize, false, "number of values to fetch at once")
+	return nil
+}
+
+func (mc *ListIterateCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	from := ec.Props().GetInt(flagFrom)
+	if err := validateIndex(flagFrom, from); err != nil {
+		return err
+	}
+	count := ec.Props().GetInt(flagCount)
+	if count < 0 {
+		return fmt.Errorf("%s cannot be negative", flagCount)
+	}
+	pageSize := ec.Props().GetInt(flagPageSize)
+	if pageSize <= 0 {
+		return fmt.Errorf("%s must be positive", flagPageSize)
+	}
+	it, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (*listIterator, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.list")
+		l, err := getList(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		pid, err := internal.StringToPartitionID(ci, name)
+		if err != nil {
+			return nil, err
+		}
+		return &listIterator{
+			ci:       ci,
+			list:     l,
+			pid:      pid,
+			next:     from,
+			wanted:   count,
+			pageSize: pageSize,
+			ec:       ec,
+		}, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	ctx, stopSignal := signal.NotifyContext(ctx, os.Interrupt, os.Kill)
+	defer stopSignal()
+	rowCh := make(chan output.Row)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- it.Iterate(ctx, rowCh)
+		close(rowCh)
+	}()
+	if err := ec.AddOutputStream(ctx, rowCh); err != nil {
+		return err
+	}
+	select {
+	case err := <-errCh:
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		return err
+	default:
+		return nil
+	}
+}
+
+// listIterator fetches the values of a list page by page.
+type listIterator struct {
+	ci       *hazelcast.ClientInternal
+	list     *hazelcast.List
+	pid      int32
+	next     int64
+	wanted   int64
+	pageSize int64
+	ec       plug.ExecContext
+}
+
+func (it *listIterator) Iterate(ctx context.Context, rowCh chan<- output.Row) error {
+	var sent int64
+	for it.wanted == 0 || sent < it.wanted {
+		// the size is checked for each page, since the list may be updated during the iteration
+		size, err := it.list.Size(ctx)
+		if err != nil {
+			return err
+		}
+		if it.next >= int64(size) {
+			return nil
+		}
+		to := min(it.next+it.pageSize, int64(size))
+		if it.wanted > 0 {
+			to = min(to, it.next+it.wanted-sent)
+		}
+		req := codec.EncodeListSubRequest(it.list.Name(), int32(it.next), int32(to))
+		resp, err := it.ci.InvokeOnPartition(ctx, req, it.pid, nil)
+		if err != nil {
+			return err
+		}
+		rows := convertDataSliceToRows(it.ec, it.ci, codec.DecodeListSubResponse(resp))
+		for _, row := range rows {
+			select {
+			case rowCh <- row:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		sent += int64(len(rows))
+		it.next = to
+	}
+	return nil
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("list:iterate", &ListIterateCommand{}))
+}
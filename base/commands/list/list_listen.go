@@ -0,0 +1,19 @@
+//go:build std || list
+
+package list
+
+import (
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+func init() {
+	c := commands.NewItemListenCommand("List", "list", commands.ItemListenerCodecs{
+		EncodeAdd:    codec.EncodeListAddListenerRequest,
+		EncodeRemove: codec.EncodeListRemoveListenerRequest,
+		Handle:       codec.HandleListAddListener,
+	})
+	check.Must(plug.Registry.RegisterCommand("list:listen", c))
+}
@@ -44,7 +44,7 @@ func (mc *ListSetCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 		if err != nil {
 			return nil, err
 		}
-		vd, err := commands.MakeValueData(ec, ci, valueStr)
+		vd, err := commands.MakeValueData(ctx, ec, ci, valueStr)
 		if err != nil {
 			return nil, err
 		}
@@ -59,7 +59,7 @@ func (mc *ListSetCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 			return nil, err
 		}
 		data := codec.DecodeListSetResponse(resp)
-		return convertDataToRow(ci, "Last Value", data, ec.Props().GetBool(base.FlagShowType))
+		return convertDataToRow(ec, ci, "Last Value", data), nil
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,70 @@
+//go:build std || list
+
+package list
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type ListSubListCommand struct{}
+
+func (mc *ListSubListCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("sub-list")
+	long := `Return the values between the given indexes in the list
+
+The from index is inclusive, and the to index is exclusive.`
+	short := "Return the values between the given indexes in the list"
+	cc.SetCommandHelp(long, short)
+	commands.AddValueTypeFlag(cc)
+	cc.AddInt64Arg(argFrom, argTitleFrom)
+	cc.AddInt64Arg(argTo, argTitleTo)
+	return nil
+}
+
+func (mc *ListSubListCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	from := ec.GetInt64Arg(argFrom)
+	to := ec.GetInt64Arg(argTo)
+	if err := validateIndex(argFrom, from); err != nil {
+		return err
+	}
+	if err := validateIndex(argTo, to); err != nil {
+		return err
+	}
+	if from > to {
+		return fmt.Errorf("%s cannot be greater than %s", argFrom, argTo)
+	}
+	rows, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) ([]output.Row, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.list")
+		sp.SetText(fmt.Sprintf("Getting the values from List '%s'", name))
+		resp, err := invokeOnList(ctx, ec, sp, codec.EncodeListSubRequest(name, int32(from), int32(to)))
+		if err != nil {
+			return nil, err
+		}
+		items := codec.DecodeListSubResponse(resp)
+		return convertDataSliceToRows(ec, ci, items), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return commands.AddDDSRows(ctx, ec, "List", "values", rows)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("list:sub-list", &ListSubListCommand{}))
+}
@@ -39,7 +39,7 @@ func makeDecodeResponseRowsFunc(decoder func(*hazelcast.ClientMessage) hazelcast
 		value, err := ci.DecodeData(data)
 		if err != nil {
 			ec.Logger().Info("The value for %s was not decoded, due to error: %s", key, err.Error())
-			value = serialization.NondecodedType(serialization.TypeToLabel(vt))
+			value = serialization.NondecodedValue(data, ec.Props().GetBool(base.FlagRaw))
 		}
 		row := output.Row{
 			output.Column{
@@ -3,6 +3,10 @@
 package _map
 
 const (
-	mapFlagReplace = "replace"
-	mapMaxIdle     = "max-idle"
+	mapFlagReplace     = "replace"
+	mapMaxIdle         = "max-idle"
+	flagPath           = "path"
+	flagPatch          = "patch"
+	argMergePatch      = "mergePatch"
+	argTitleMergePatch = "merge patch"
 )
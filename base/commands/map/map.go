@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/objects"
 	"github.com/hazelcast/hazelcast-commandline-client/clc"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
@@ -21,7 +22,9 @@ func (Command) Init(cc plug.InitContext) error {
 	help := "Map operations"
 	cc.SetCommandHelp(help, help)
 	cc.AddStringFlag(base.FlagName, "n", base.DefaultName, false, "map name")
+	cc.SetFlagCompletion(base.FlagName, objects.NameCompletion("map"))
 	cc.AddBoolFlag(base.FlagShowType, "", false, false, "add the type names to the output")
+	cc.AddBoolFlag(base.FlagRaw, "", false, false, "show the serialization details of the values which cannot be decoded")
 	return nil
 }
 
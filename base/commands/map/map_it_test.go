@@ -6,9 +6,11 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hazelcast/hazelcast-go-client"
 	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
 	"github.com/hazelcast/hazelcast-go-client/types"
 	"github.com/stretchr/testify/require"
 
@@ -23,8 +25,15 @@ func TestMap(t *testing.T) {
 		f    func(t *testing.T)
 	}{
 		{name: "Clear_NonInteractive", f: clear_NonInteractiveTest},
+		{name: "Complete_NonInteractive", f: complete_NonInteractiveTest},
 		{name: "EntrySet_NonInteractive", f: entrySet_NonInteractiveTest},
 		{name: "Get_Noninteractive", f: get_NonInteractiveTest},
+		{name: "Get_AutoKeyType_Noninteractive", f: get_AutoKeyType_NonInteractiveTest},
+		{name: "Get_AutoKeyType_Interactive", f: get_AutoKeyType_InteractiveTest},
+		{name: "Journal_NonInteractive", f: journal_NonInteractiveTest},
+		{name: "JSONGet_NonInteractive", f: jsonGet_NonInteractiveTest},
+		{name: "JSONMerge_NonInteractive", f: jsonMerge_NonInteractiveTest},
+		{name: "JSONPatch_NonInteractive", f: jsonPatch_NonInteractiveTest},
 		{name: "Remove_Noninteractive", f: remove_NonInteractiveTest},
 		{name: "Set_NonInteractive", f: set_NonInteractiveTest},
 		{name: "Size_Interactive", f: size_InteractiveTest},
@@ -46,6 +55,35 @@ func TestMap(t *testing.T) {
 	}
 }
 
+func journal_NonInteractiveTest(t *testing.T) {
+	it.MapTesterWithName(t, it.NewUniqueObjectName("map", "journal"), func(tcx it.TestContext, m *hz.Map) {
+		ctx := context.Background()
+		check.Must(m.Set(ctx, "foo", "bar"))
+		check.Must(m.Set(ctx, "foo", "baz"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "map", "-n", m.Name(), "journal", "--projection", "type,key,value,old-value", "-q"))
+			tcx.AssertStdoutEquals("ADDED\tfoo\tbar\t-\nUPDATED\tfoo\tbaz\tbar\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "map", "-n", m.Name(), "journal", "--predicate", "type=updated", "--projection", "value", "-q"))
+			tcx.AssertStdoutEquals("baz\n")
+		})
+		tcx.WithReset(func() {
+			// only the partition of the key is read
+			check.Must(tcx.CLC().Execute(ctx, "map", "-n", m.Name(), "journal", "--predicate", "key=foo", "--key-type", "string", "--projection", "type,value", "-q"))
+			tcx.AssertStdoutEquals("ADDED\tbar\nUPDATED\tbaz\n")
+		})
+		tcx.WithReset(func() {
+			go func() {
+				check.Must(tcx.CLC().Execute(ctx, "map", "-n", m.Name(), "journal", "--from-sequence", "newest", "--follow", "--count", "1", "--projection", "key,value", "-q"))
+			}()
+			time.Sleep(1 * time.Second)
+			check.Must(m.Set(ctx, "foo", "qux"))
+			tcx.AssertStdoutContains("foo\tqux\n")
+		})
+	})
+}
+
 func clear_NonInteractiveTest(t *testing.T) {
 	it.MapTester(t, func(tcx it.TestContext, m *hz.Map) {
 		t := tcx.T
@@ -59,6 +97,19 @@ func clear_NonInteractiveTest(t *testing.T) {
 	})
 }
 
+func complete_NonInteractiveTest(t *testing.T) {
+	it.MapTester(t, func(tcx it.TestContext, m *hz.Map) {
+		ctx := context.Background()
+		check.Must(m.Set(ctx, "foo", "bar"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "__complete", "map", "get", "--name", m.Name()[:len(m.Name())-1]))
+			tcx.AssertStdoutContains(m.Name() + "\n")
+			// the completion directive for not suggesting file names
+			tcx.AssertStdoutContains(":4\n")
+		})
+	})
+}
+
 func entrySet_NonInteractiveTest(t *testing.T) {
 	it.MapTester(t, func(tcx it.TestContext, m *hz.Map) {
 		ctx := context.Background()
@@ -98,6 +149,71 @@ func get_NonInteractiveTest(t *testing.T) {
 	})
 }
 
+func get_AutoKeyType_NonInteractiveTest(t *testing.T) {
+	it.MapTester(t, func(tcx it.TestContext, m *hz.Map) {
+		ctx := context.Background()
+		// the key type is resolved from the existing entry
+		tcx.WithReset(func() {
+			check.Must(m.Set(context.Background(), int32(10), "bar"))
+			check.Must(tcx.CLC().Execute(ctx, "map", "-n", m.Name(), "get", "10", "--key-type", "auto", "-q"))
+			tcx.AssertStdoutEquals("bar\n")
+		})
+	})
+}
+
+func get_AutoKeyType_InteractiveTest(t *testing.T) {
+	it.MapTester(t, func(tcx it.TestContext, m *hz.Map) {
+		ctx := context.Background()
+		check.Must(m.Set(ctx, int32(10), "bar"))
+		tcx.WithShell(ctx, func(tcx it.TestContext) {
+			tcx.WithReset(func() {
+				tcx.WriteStdinf("\\map -n %s get 10 --key-type auto\n", m.Name())
+				tcx.AssertStdoutContains("bar")
+			})
+		})
+	})
+}
+
+func jsonGet_NonInteractiveTest(t *testing.T) {
+	it.MapTester(t, func(tcx it.TestContext, m *hz.Map) {
+		ctx := context.Background()
+		check.Must(m.Set(ctx, "foo", serialization.JSON(`{"name": "Jane", "address": {"city": "Istanbul"}, "tags": ["a", "b"]}`)))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "map", "-n", m.Name(), "json-get", "foo", "--path", "$.address.city", "-q"))
+			tcx.AssertStdoutEquals("\"Istanbul\"\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "map", "-n", m.Name(), "json-get", "foo", "--path", "$.tags[*]", "-q"))
+			tcx.AssertStdoutEquals("\"a\"\n\"b\"\n")
+		})
+	})
+}
+
+func jsonMerge_NonInteractiveTest(t *testing.T) {
+	it.MapTester(t, func(tcx it.TestContext, m *hz.Map) {
+		ctx := context.Background()
+		check.Must(m.Set(ctx, "foo", serialization.JSON(`{"name": "Jane", "address": {"city": "Istanbul", "zip": "34000"}}`)))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "map", "-n", m.Name(), "json-merge", "foo", `{"address": {"city": "Ankara", "zip": null}}`, "-q"))
+			v := check.MustValue(m.Get(ctx, "foo"))
+			require.Equal(t, serialization.JSON(`{"address":{"city":"Ankara"},"name":"Jane"}`), v)
+		})
+	})
+}
+
+func jsonPatch_NonInteractiveTest(t *testing.T) {
+	it.MapTester(t, func(tcx it.TestContext, m *hz.Map) {
+		ctx := context.Background()
+		check.Must(m.Set(ctx, "foo", serialization.JSON(`{"name": "Jane", "tags": ["a"]}`)))
+		tcx.WithReset(func() {
+			patch := `[{"op": "replace", "path": "/name", "value": "John"}, {"op": "add", "path": "/tags/-", "value": "b"}]`
+			check.Must(tcx.CLC().Execute(ctx, "map", "-n", m.Name(), "json-patch", "foo", "--patch", patch, "-q"))
+			v := check.MustValue(m.Get(ctx, "foo"))
+			require.Equal(t, serialization.JSON(`{"name":"John","tags":["a","b"]}`), v)
+		})
+	})
+}
+
 func remove_NonInteractiveTest(t *testing.T) {
 	it.MapTester(t, func(tcx it.TestContext, m *hz.Map) {
 		ctx := context.Background()
@@ -0,0 +1,61 @@
+//go:build std || map
+
+package _map
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+func getMapName(ctx context.Context, ec plug.ExecContext, sp clc.Spinner) (string, error) {
+	if _, err := getMap(ctx, ec, sp); err != nil {
+		return "", err
+	}
+	return ec.Props().GetString(base.FlagName), nil
+}
+
+// mapEventTypeLabel returns the name of the given com.hazelcast.core.EntryEventType.
+func mapEventTypeLabel(t int32) string {
+	switch t {
+	case 1:
+		return "ADDED"
+	case 2:
+		return "REMOVED"
+	case 4:
+		return "UPDATED"
+	case 8:
+		return "EVICTED"
+	case 16:
+		return "EXPIRED"
+	case 32:
+		return "EVICT_ALL"
+	case 64:
+		return "CLEAR_ALL"
+	case 128:
+		return "MERGED"
+	case 256:
+		return "INVALIDATION"
+	case 512:
+		return "LOADED"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", t)
+	}
+}
+
+func init() {
+	codecs := commands.JournalCodecs{
+		EncodeSubscribe: codec.EncodeMapEventJournalSubscribeRequest,
+		DecodeSubscribe: codec.DecodeMapEventJournalSubscribeResponse,
+		EncodeRead:      codec.EncodeMapEventJournalReadRequest,
+		DecodeRead:      codec.DecodeMapEventJournalReadResponse,
+	}
+	c := commands.NewJournalCommand("Map", "map", codecs, getMapName, mapEventTypeLabel)
+	check.Must(plug.Registry.RegisterCommand("map:journal", c))
+}
@@ -0,0 +1,105 @@
+//go:build std || map
+
+package _map
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	iserialization "github.com/hazelcast/hazelcast-go-client/serialization"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/jsondoc"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+// jsonUpdateMaxAttempts is the number of times a JSON value is read and replaced, if it is modified concurrently.
+const jsonUpdateMaxAttempts = 10
+
+// getJSONValue returns the JSON value for the given key, and its serialized form.
+func getJSONValue(ctx context.Context, ci *hazelcast.ClientInternal, mapName, key string, keyData hazelcast.Data) (any, hazelcast.Data, error) {
+	req := codec.EncodeMapGetRequest(mapName, keyData, 0)
+	resp, err := ci.InvokeOnKey(ctx, req, keyData, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	data := codec.DecodeMapGetResponse(resp)
+	if data == nil {
+		return nil, nil, fmt.Errorf("no value for key %s in Map '%s'", key, mapName)
+	}
+	if data.Type() != serialization.TypeJSONSerialization {
+		return nil, nil, fmt.Errorf("the value for key %s is not a JSON value: %s", key, serialization.TypeToLabel(data.Type()))
+	}
+	v, err := ci.DecodeData(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	doc, err := jsondoc.Decode(v.(iserialization.JSON))
+	if err != nil {
+		return nil, nil, fmt.Errorf("decoding the value for key %s: %w", key, err)
+	}
+	return doc, data, nil
+}
+
+// updateJSONValue replaces the JSON value for the key argument with the result of the update function.
+// The value is replaced only if it was not modified since it was read, otherwise the update is retried.
+func updateJSONValue(ctx context.Context, ec plug.ExecContext, update func(doc any) (any, error)) error {
+	mapName := ec.Props().GetString(base.FlagName)
+	key := ec.GetStringArg(commands.ArgKey)
+	_, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.map")
+		if _, err = getMap(ctx, ec, sp); err != nil {
+			return nil, err
+		}
+		sp.SetText(fmt.Sprintf("Updating the JSON value in Map '%s'", mapName))
+		kd, err := commands.MakeKeyData(ctx, ec, ci, key)
+		if err != nil {
+			return nil, err
+		}
+		for i := 1; i <= jsonUpdateMaxAttempts; i++ {
+			doc, vd, err := getJSONValue(ctx, ci, mapName, key, kd)
+			if err != nil {
+				return nil, err
+			}
+			doc, err = update(doc)
+			if err != nil {
+				return nil, err
+			}
+			b, err := jsondoc.Encode(doc)
+			if err != nil {
+				return nil, err
+			}
+			nd, err := ci.EncodeData(iserialization.JSON(b))
+			if err != nil {
+				return nil, err
+			}
+			req := codec.EncodeMapReplaceIfSameRequest(mapName, kd, vd, nd, 0)
+			resp, err := ci.InvokeOnKey(ctx, req, kd, nil)
+			if err != nil {
+				return nil, err
+			}
+			if codec.DecodeMapReplaceIfSameResponse(resp) {
+				return nil, nil
+			}
+			ec.Logger().Debugf("The value for %s was modified concurrently, attempt: %d", key, i)
+		}
+		return nil, fmt.Errorf("the value for key %s was modified concurrently, gave up after %d attempts", key, jsonUpdateMaxAttempts)
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	msg := fmt.Sprintf("OK Updated the JSON value in the Map '%s'.", mapName)
+	ec.PrintlnUnnecessary(msg)
+	return nil
+}
@@ -0,0 +1,98 @@
+//go:build std || map
+
+package _map
+
+import (
+	"context"
+	"fmt"
+
+	iserialization "github.com/hazelcast/hazelcast-go-client/serialization"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/jsondoc"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+type MapJSONGetCommand struct{}
+
+func (MapJSONGetCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("json-get")
+	long := `Get a part of a JSON value in the given Map using a JSONPath expression
+
+The following subset of JSONPath is supported:
+  * $: the root value
+  * .name or ['name']: a member of an object
+  * [index]: an item of an array, negative indexes count from the end
+  * .* or [*]: all members of an object or all items of an array
+`
+	short := "Get a part of a JSON value in the given Map"
+	cc.SetCommandHelp(long, short)
+	commands.AddKeyTypeFlag(cc)
+	cc.AddStringFlag(flagPath, "", "$", false, "JSONPath expression")
+	cc.AddStringArg(commands.ArgKey, commands.ArgTitleKey)
+	return nil
+}
+
+func (MapJSONGetCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	mapName := ec.Props().GetString(base.FlagName)
+	key := ec.GetStringArg(commands.ArgKey)
+	path, err := jsondoc.ParsePath(ec.Props().GetString(flagPath))
+	if err != nil {
+		return err
+	}
+	rowsV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.map")
+		if _, err = getMap(ctx, ec, sp); err != nil {
+			return nil, err
+		}
+		sp.SetText(fmt.Sprintf("Getting from Map '%s'", mapName))
+		kd, err := commands.MakeKeyData(ctx, ec, ci, key)
+		if err != nil {
+			return nil, err
+		}
+		doc, _, err := getJSONValue(ctx, ci, mapName, key, kd)
+		if err != nil {
+			return nil, err
+		}
+		vs := path.Find(doc)
+		rows := make([]output.Row, len(vs))
+		for i, v := range vs {
+			b, err := jsondoc.Encode(v)
+			if err != nil {
+				return nil, err
+			}
+			rows[i] = output.Row{
+				output.Column{
+					Name:  output.NameValue,
+					Type:  serialization.TypeJSONSerialization,
+					Value: iserialization.JSON(b),
+				},
+			}
+		}
+		return rows, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	rows := rowsV.([]output.Row)
+	if len(rows) == 0 {
+		ec.PrintlnUnnecessary(fmt.Sprintf("OK No values matched %s.", path))
+		return nil
+	}
+	return ec.AddOutputRows(ctx, rows...)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("map:json-get", &MapJSONGetCommand{}))
+}
@@ -0,0 +1,43 @@
+//go:build std || map
+
+package _map
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/jsondoc"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+type MapJSONMergeCommand struct{}
+
+func (MapJSONMergeCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("json-merge")
+	long := `Merge a JSON Merge Patch (RFC 7396) into a JSON value in the given Map
+
+The members of the patch replace the members of the value, and null members are removed from the value.
+The value is replaced only if it was not modified after it was read, otherwise merging is retried.
+`
+	short := "Merge a JSON Merge Patch into a JSON value in the given Map"
+	cc.SetCommandHelp(long, short)
+	commands.AddKeyTypeFlag(cc)
+	cc.AddStringArg(commands.ArgKey, commands.ArgTitleKey)
+	cc.AddStringArg(argMergePatch, argTitleMergePatch)
+	return nil
+}
+
+func (MapJSONMergeCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	patch, err := jsondoc.Decode([]byte(ec.GetStringArg(argMergePatch)))
+	if err != nil {
+		return err
+	}
+	return updateJSONValue(ctx, ec, func(doc any) (any, error) {
+		return jsondoc.MergePatch(doc, patch), nil
+	})
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("map:json-merge", &MapJSONMergeCommand{}))
+}
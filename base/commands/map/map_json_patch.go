@@ -0,0 +1,40 @@
+//go:build std || map
+
+package _map
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/jsondoc"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+type MapJSONPatchCommand struct{}
+
+func (MapJSONPatchCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("json-patch")
+	long := `Apply a JSON Patch (RFC 6902) to a JSON value in the given Map
+
+The value is replaced only if it was not modified after it was read, otherwise patching is retried.
+`
+	short := "Apply a JSON Patch to a JSON value in the given Map"
+	cc.SetCommandHelp(long, short)
+	commands.AddKeyTypeFlag(cc)
+	cc.AddStringFlag(flagPatch, "", "", true, "JSON Patch document")
+	cc.AddStringArg(commands.ArgKey, commands.ArgTitleKey)
+	return nil
+}
+
+func (MapJSONPatchCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	patch, err := jsondoc.DecodePatch([]byte(ec.Props().GetString(flagPatch)))
+	if err != nil {
+		return err
+	}
+	return updateJSONValue(ctx, ec, patch.Apply)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("map:json-patch", &MapJSONPatchCommand{}))
+}
@@ -44,7 +44,7 @@ func (MapLoadAllCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 		cmd.IncrementClusterMetric(ctx, ec, "total.map")
 		var keys []hazelcast.Data
 		for _, keyStr := range ec.GetStringSliceArg(commands.ArgKey) {
-			keyData, err := commands.MakeKeyData(ec, ci, keyStr)
+			keyData, err := commands.MakeKeyData(ctx, ec, ci, keyStr)
 			if err != nil {
 				return nil, err
 			}
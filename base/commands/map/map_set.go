@@ -47,7 +47,7 @@ func (MapSetCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 		}
 		key := ec.GetStringArg(commands.ArgKey)
 		value := ec.GetStringArg(base.ArgValue)
-		kd, vd, err := commands.MakeKeyValueData(ec, ci, key, value)
+		kd, vd, err := commands.MakeKeyValueData(ctx, ec, ci, key, value)
 		if err != nil {
 			return nil, err
 		}
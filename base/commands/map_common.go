@@ -48,6 +48,7 @@ func (cm MapEntrySetCommand[T]) Init(cc plug.InitContext) error {
 func (cm MapEntrySetCommand[T]) Exec(ctx context.Context, ec plug.ExecContext) error {
 	name := ec.Props().GetString(base.FlagName)
 	showType := ec.Props().GetBool(base.FlagShowType)
+	raw := ec.Props().GetBool(base.FlagRaw)
 	rowsV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
 		ci, err := cmd.ClientInternal(ctx, ec, sp)
 		if err != nil {
@@ -64,7 +65,7 @@ func (cm MapEntrySetCommand[T]) Exec(ctx context.Context, ec plug.ExecContext) e
 			return nil, err
 		}
 		pairs := cm.decoder(resp)
-		rows := output.DecodePairs(ci, pairs, showType)
+		rows := output.DecodePairs(ci, pairs, showType, raw)
 		return rows, nil
 	})
 	if err != nil {
@@ -117,7 +118,7 @@ func (cm MapGetCommand[T]) Exec(ctx context.Context, ec plug.ExecContext) error
 			return nil, err
 		}
 		sp.SetText(fmt.Sprintf("Getting from %s '%s'", cm.typeName, name))
-		keyData, err := MakeKeyData(ec, ci, keyStr)
+		keyData, err := MakeKeyData(ctx, ec, ci, keyStr)
 		if err != nil {
 			return nil, err
 		}
@@ -170,6 +171,7 @@ func (cm MapKeySetCommand[T]) Init(cc plug.InitContext) error {
 func (cm MapKeySetCommand[T]) Exec(ctx context.Context, ec plug.ExecContext) error {
 	name := ec.Props().GetString(base.FlagName)
 	showType := ec.Props().GetBool(base.FlagShowType)
+	raw := ec.Props().GetBool(base.FlagRaw)
 	rowsV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
 		ci, err := cmd.ClientInternal(ctx, ec, sp)
 		if err != nil {
@@ -192,7 +194,7 @@ func (cm MapKeySetCommand[T]) Exec(ctx context.Context, ec plug.ExecContext) err
 			t := r.Type()
 			v, err := ci.DecodeData(*r)
 			if err != nil {
-				v = serialization.NondecodedType(serialization.TypeToLabel(t))
+				v = serialization.NondecodedValue(*r, raw)
 			}
 			row = append(row, output.NewKeyColumn(t, v))
 			if showType {
@@ -244,7 +246,7 @@ func (cm MapRemoveCommand) Exec(ctx context.Context, ec plug.ExecContext) error
 		}
 		cmd.IncrementClusterMetric(ctx, ec, "total."+cm.metricName)
 		sp.SetText(fmt.Sprintf("Removing from %s '%s'", cm.typeName, name))
-		keyData, err := MakeKeyData(ec, ci, keyStr)
+		keyData, err := MakeKeyData(ctx, ec, ci, keyStr)
 		if err != nil {
 			return nil, err
 		}
@@ -484,6 +486,7 @@ func (cm MapValuesCommand[T]) Init(cc plug.InitContext) error {
 func (cm *MapValuesCommand[T]) Exec(ctx context.Context, ec plug.ExecContext) error {
 	name := ec.Props().GetString(base.FlagName)
 	showType := ec.Props().GetBool(base.FlagShowType)
+	raw := ec.Props().GetBool(base.FlagRaw)
 	rowsV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
 		ci, err := cmd.ClientInternal(ctx, ec, sp)
 		if err != nil {
@@ -506,7 +509,7 @@ func (cm *MapValuesCommand[T]) Exec(ctx context.Context, ec plug.ExecContext) er
 			t := r.Type()
 			v, err := ci.DecodeData(*r)
 			if err != nil {
-				v = serialization.NondecodedType(serialization.TypeToLabel(t))
+				v = serialization.NondecodedValue(*r, raw)
 			}
 			row = append(row, output.NewValueColumn(t, v))
 			if showType {
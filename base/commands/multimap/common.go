@@ -41,7 +41,7 @@ func makeDecodeResponseRowsFunc(decoder func(*hazelcast.ClientMessage) []*hazelc
 			value, err := ci.DecodeData(*r)
 			if err != nil {
 				ec.Logger().Info("The value for %s was not decoded, due to error: %s", key, err.Error())
-				value = serialization.NondecodedType(serialization.TypeToLabel(vt))
+				value = serialization.NondecodedValue(*r, ec.Props().GetBool(base.FlagRaw))
 			}
 			row := output.Row{
 				output.Column{
@@ -41,7 +41,7 @@ func (MultiMapPutCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 		}
 		cmd.IncrementClusterMetric(ctx, ec, "total.multimap")
 		sp.SetText(fmt.Sprintf("Putting value into MultiMap '%s'", name))
-		kd, vd, err := commands.MakeKeyValueData(ec, ci, keyStr, valueStr)
+		kd, vd, err := commands.MakeKeyValueData(ctx, ec, ci, keyStr, valueStr)
 		if err != nil {
 			return nil, err
 		}
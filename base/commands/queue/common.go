@@ -11,7 +11,10 @@ import (
 	"github.com/hazelcast/hazelcast-commandline-client/base"
 	"github.com/hazelcast/hazelcast-commandline-client/clc"
 	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
 )
 
 func getQueue(ctx context.Context, ec plug.ExecContext, sp clc.Spinner) (*hazelcast.Queue, error) {
@@ -23,3 +26,44 @@ func getQueue(ctx context.Context, ec plug.ExecContext, sp clc.Spinner) (*hazelc
 	sp.SetText(fmt.Sprintf("Getting Queue '%s'", name))
 	return ci.Client().GetQueue(ctx, name)
 }
+
+// invoke sends the request to the partition which owns the queue.
+func invoke(ctx context.Context, ci *hazelcast.ClientInternal, name string, req *hazelcast.ClientMessage) (*hazelcast.ClientMessage, error) {
+	pID, err := internal.StringToPartitionID(ci, name)
+	if err != nil {
+		return nil, err
+	}
+	return ci.InvokeOnPartition(ctx, req, pID, nil)
+}
+
+func makeValueRow(ec plug.ExecContext, ci *hazelcast.ClientInternal, data hazelcast.Data) output.Row {
+	vt := data.Type()
+	value, err := ci.DecodeData(data)
+	if err != nil {
+		ec.Logger().Info("The value was not decoded, due to error: %s", err.Error())
+		value = serialization.NondecodedValue(data, ec.Props().GetBool(base.FlagRaw))
+	}
+	row := output.Row{
+		output.Column{
+			Name:  output.NameValue,
+			Type:  vt,
+			Value: value,
+		},
+	}
+	if ec.Props().GetBool(base.FlagShowType) {
+		row = append(row, output.Column{
+			Name:  output.NameValueType,
+			Type:  serialization.TypeString,
+			Value: serialization.TypeToLabel(vt),
+		})
+	}
+	return row
+}
+
+func makeValueRows(ec plug.ExecContext, ci *hazelcast.ClientInternal, items []*hazelcast.Data) []output.Row {
+	rows := make([]output.Row, len(items))
+	for i, item := range items {
+		rows[i] = makeValueRow(ec, ci, *item)
+	}
+	return rows
+}
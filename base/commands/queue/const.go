@@ -0,0 +1,14 @@
+//go:build std || queue
+
+package queue
+
+const (
+	flagCount   = "count"
+	flagFile    = "file"
+	flagMax     = "max"
+	flagTimeout = "timeout"
+	// addAllBatchSize is the maximum number of values sent to the cluster in a single AddAll request.
+	addAllBatchSize = 1000
+	// drainAll makes the member drain all the items in the queue.
+	drainAll = -1
+)
@@ -0,0 +1,69 @@
+//go:build std || queue
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type DrainCommand struct{}
+
+func (DrainCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("drain")
+	long := `Remove elements from the given Queue and print them
+
+All elements are removed unless the maximum number of elements is given.`
+	short := "Remove elements from the given Queue and print them"
+	cc.SetCommandHelp(long, short)
+	commands.AddValueTypeFlag(cc)
+	cc.AddIntFlag(flagMax, "", 0, false, "maximum number of elements to remove, 0 removes all elements")
+	return nil
+}
+
+func (DrainCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	maxSize := ec.Props().GetInt(flagMax)
+	if maxSize < 0 {
+		return fmt.Errorf("%s cannot be negative", flagMax)
+	}
+	if maxSize > math.MaxInt32 {
+		return fmt.Errorf("%s cannot be greater than %d", flagMax, math.MaxInt32)
+	}
+	if maxSize == 0 {
+		maxSize = drainAll
+	}
+	rows, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) ([]output.Row, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.queue")
+		sp.SetText(fmt.Sprintf("Draining Queue '%s'", name))
+		req := codec.EncodeQueueDrainToMaxSizeRequest(name, int32(maxSize))
+		resp, err := invoke(ctx, ci, name, req)
+		if err != nil {
+			return nil, err
+		}
+		return makeValueRows(ec, ci, codec.DecodeQueueDrainToMaxSizeResponse(resp)), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return commands.AddDDSRows(ctx, ec, "Queue", "elements", rows)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("queue:drain", &DrainCommand{}))
+}
@@ -4,7 +4,10 @@ package queue_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
 	"github.com/hazelcast/hazelcast-go-client/types"
@@ -21,8 +24,14 @@ func TestQueue(t *testing.T) {
 		f    func(t *testing.T)
 	}{
 		{name: "Clear_NonInteractive", f: clear_NonInteractiveTest},
+		{name: "Drain_NonInteractive", f: drain_NonInteractiveTest},
+		{name: "Iterate_NonInteractive", f: iterate_NonInteractiveTest},
+		{name: "Listen_NonInteractive", f: listen_NonInteractiveTest},
+		{name: "Peek_NonInteractive", f: peek_NonInteractiveTest},
 		{name: "Poll_Noninteractive", f: poll_NonInteractiveTest},
+		{name: "PollTimeout_Noninteractive", f: pollTimeout_NonInteractiveTest},
 		{name: "Offer_NonInteractive", f: offer_NonInteractiveTest},
+		{name: "OfferFile_NonInteractive", f: offerFile_NonInteractiveTest},
 		{name: "Size_Noninteractive", f: size_NoninteractiveTest},
 		{name: "Destroy_NonInteractiveTest", f: destroy_NonInteractiveTest},
 	}
@@ -44,6 +53,66 @@ func clear_NonInteractiveTest(t *testing.T) {
 	})
 }
 
+func drain_NonInteractiveTest(t *testing.T) {
+	it.QueueTester(t, func(tcx it.TestContext, q *hz.Queue) {
+		t := tcx.T
+		ctx := context.Background()
+		check.MustValue(q.AddAll(ctx, "foo", "bar", "baz"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "queue", "-n", q.Name(), "drain", "--max", "2", "-q"))
+			tcx.AssertStdoutEquals("foo\nbar\n")
+			require.Equal(t, 1, check.MustValue(q.Size(ctx)))
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "queue", "-n", q.Name(), "drain", "-q"))
+			tcx.AssertStdoutEquals("baz\n")
+			require.Equal(t, 0, check.MustValue(q.Size(ctx)))
+		})
+	})
+}
+
+func iterate_NonInteractiveTest(t *testing.T) {
+	it.QueueTester(t, func(tcx it.TestContext, q *hz.Queue) {
+		t := tcx.T
+		ctx := context.Background()
+		check.MustValue(q.AddAll(ctx, "foo", "bar"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "queue", "-n", q.Name(), "iterate", "-q", "--show-type"))
+			tcx.AssertStdoutEquals("foo\tSTRING\nbar\tSTRING\n")
+			require.Equal(t, 2, check.MustValue(q.Size(ctx)))
+		})
+	})
+}
+
+func listen_NonInteractiveTest(t *testing.T) {
+	it.QueueTester(t, func(tcx it.TestContext, q *hz.Queue) {
+		ctx := context.Background()
+		tcx.WithReset(func() {
+			go func() {
+				check.Must(tcx.CLC().Execute(ctx, "queue", "-n", q.Name(), "listen", "--count", "2"))
+			}()
+			time.Sleep(1 * time.Second)
+			check.MustValue(q.Add(ctx, "foo"))
+			check.MustValue(q.Poll(ctx))
+			tcx.AssertStdoutContains("ADDED")
+			tcx.AssertStdoutContains("REMOVED")
+		})
+	})
+}
+
+func peek_NonInteractiveTest(t *testing.T) {
+	it.QueueTester(t, func(tcx it.TestContext, q *hz.Queue) {
+		t := tcx.T
+		ctx := context.Background()
+		check.MustValue(q.AddAll(ctx, "foo", "bar"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "queue", "-n", q.Name(), "peek", "-q"))
+			tcx.AssertStdoutEquals("foo\n")
+			require.Equal(t, 2, check.MustValue(q.Size(ctx)))
+		})
+	})
+}
+
 func poll_NonInteractiveTest(t *testing.T) {
 	it.QueueTester(t, func(tcx it.TestContext, q *hz.Queue) {
 		t := tcx.T
@@ -55,6 +124,27 @@ func poll_NonInteractiveTest(t *testing.T) {
 			tcx.AssertStdoutEquals("foo\tSTRING\n")
 			require.Equal(t, 0, check.MustValue(q.Size(ctx)))
 		})
+		tcx.WithReset(func() {
+			check.MustValue(q.AddAll(ctx, "foo", "bar"))
+			// only the available elements are removed
+			check.Must(tcx.CLC().Execute(ctx, "queue", "-n", q.Name(), "poll", "--count", "3", "-q"))
+			tcx.AssertStdoutEquals("foo\nbar\n")
+			require.Equal(t, 0, check.MustValue(q.Size(ctx)))
+		})
+	})
+}
+
+func pollTimeout_NonInteractiveTest(t *testing.T) {
+	it.QueueTester(t, func(tcx it.TestContext, q *hz.Queue) {
+		ctx := context.Background()
+		tcx.WithReset(func() {
+			go func() {
+				time.Sleep(1 * time.Second)
+				check.MustValue(q.Add(ctx, "foo"))
+			}()
+			check.Must(tcx.CLC().Execute(ctx, "queue", "-n", q.Name(), "poll", "--timeout", "10000", "-q"))
+			tcx.AssertStdoutEquals("foo\n")
+		})
 	})
 }
 
@@ -69,6 +159,36 @@ func offer_NonInteractiveTest(t *testing.T) {
 	})
 }
 
+func offerFile_NonInteractiveTest(t *testing.T) {
+	it.QueueTester(t, func(tcx it.TestContext, q *hz.Queue) {
+		t := tcx.T
+		ctx := context.Background()
+		dir := t.TempDir()
+		ndjsonPath := filepath.Join(dir, "values.ndjson")
+		check.Must(os.WriteFile(ndjsonPath, []byte("{\"id\": 1}\n\n{\"id\": 2}\n"), 0600))
+		csvPath := filepath.Join(dir, "values.csv")
+		check.Must(os.WriteFile(csvPath, []byte("id,name\n3,foo\n"), 0600))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "queue", "-n", q.Name(), "offer", "--file", ndjsonPath))
+			tcx.AssertStdoutContains("OK Added 2 values")
+			require.Equal(t, 2, check.MustValue(q.Size(ctx)))
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "queue", "-n", q.Name(), "offer", "--file", csvPath))
+			require.Equal(t, 3, check.MustValue(q.Size(ctx)))
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "queue", "-n", q.Name(), "iterate", "-q"))
+			tcx.AssertStdoutEquals(`{"id": 1}` + "\n" + `{"id": 2}` + "\n" + `{"id":"3","name":"foo"}` + "\n")
+		})
+		tcx.WithReset(func() {
+			err := tcx.CLC().Execute(ctx, "queue", "-n", q.Name(), "offer", "--file", csvPath, "--value-type", "int64")
+			require.EqualError(t, err, "--value-type cannot be used with --file, the values in the file are JSON")
+			require.Equal(t, 3, check.MustValue(q.Size(ctx)))
+		})
+	})
+}
+
 func size_NoninteractiveTest(t *testing.T) {
 	it.QueueTester(t, func(tcx it.TestContext, q *hz.Queue) {
 		ctx := context.Background()
@@ -0,0 +1,56 @@
+//go:build std || queue
+
+package queue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type IterateCommand struct{}
+
+func (IterateCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("iterate")
+	long := `List the elements of the given Queue without removing them
+
+The elements are listed in the order they would be polled.`
+	short := "List the elements of the given Queue without removing them"
+	cc.SetCommandHelp(long, short)
+	commands.AddValueTypeFlag(cc)
+	return nil
+}
+
+func (IterateCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	rows, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) ([]output.Row, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.queue")
+		sp.SetText(fmt.Sprintf("Iterating over Queue '%s'", name))
+		resp, err := invoke(ctx, ci, name, codec.EncodeQueueIteratorRequest(name))
+		if err != nil {
+			return nil, err
+		}
+		return makeValueRows(ec, ci, codec.DecodeQueueIteratorResponse(resp)), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return commands.AddDDSRows(ctx, ec, "Queue", "elements", rows)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("queue:iterate", &IterateCommand{}))
+}
@@ -0,0 +1,19 @@
+//go:build std || queue
+
+package queue
+
+import (
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+func init() {
+	c := commands.NewItemListenCommand("Queue", "queue", commands.ItemListenerCodecs{
+		EncodeAdd:    codec.EncodeQueueAddListenerRequest,
+		EncodeRemove: codec.EncodeQueueRemoveListenerRequest,
+		Handle:       codec.HandleQueueAddListener,
+	})
+	check.Must(plug.Registry.RegisterCommand("queue:listen", c))
+}
@@ -6,6 +6,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hazelcast/hazelcast-go-client"
+	iserialization "github.com/hazelcast/hazelcast-go-client/serialization"
+
 	"github.com/hazelcast/hazelcast-commandline-client/base"
 	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
 	"github.com/hazelcast/hazelcast-commandline-client/clc"
@@ -13,6 +16,7 @@ import (
 	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
 )
 
@@ -20,15 +24,34 @@ type QueueOfferCommand struct{}
 
 func (QueueOfferCommand) Init(cc plug.InitContext) error {
 	cc.SetCommandUsage("offer")
-	help := "Add values to the given Queue"
-	cc.SetCommandHelp(help, help)
+	long := `Add values to the given Queue
+
+The values can be given as arguments or read from a file using the --file flag.
+` + commands.ValuesFileHelp
+	short := "Add values to the given Queue"
+	cc.SetCommandHelp(long, short)
 	commands.AddValueTypeFlag(cc)
-	cc.AddStringSliceArg(base.ArgValue, base.ArgTitleValue, 1, clc.MaxArgs)
+	cc.AddStringFlag(flagFile, "", "", false, "CSV or NDJSON file to read the values from")
+	cc.AddStringSliceArg(base.ArgValue, base.ArgTitleValue, 0, clc.MaxArgs)
 	return nil
 }
 
 func (QueueOfferCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 	name := ec.Props().GetString(base.FlagName)
+	path := ec.Props().GetString(flagFile)
+	values := ec.GetStringSliceArg(base.ArgValue)
+	if path != "" {
+		if len(values) > 0 {
+			return fmt.Errorf("values cannot be given together with --%s", flagFile)
+		}
+		if err := commands.CheckValuesFileValueType(ec, flagFile); err != nil {
+			return err
+		}
+		return offerFile(ctx, ec, name, path)
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("either values or --%s must be given", flagFile)
+	}
 	rowsV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
 		ci, err := cmd.ClientInternal(ctx, ec, sp)
 		if err != nil {
@@ -41,8 +64,8 @@ func (QueueOfferCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 		}
 		sp.SetText(fmt.Sprintf("Adding values into Queue '%s'", name))
 		var rows []output.Row
-		for _, arg := range ec.GetStringSliceArg(base.ArgValue) {
-			vd, err := commands.MakeValueData(ec, ci, arg)
+		for _, arg := range values {
+			vd, err := commands.MakeValueData(ctx, ec, ci, arg)
 			if err != nil {
 				return nil, err
 			}
@@ -66,12 +89,63 @@ func (QueueOfferCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 		return rows, nil
 	})
 	if err != nil {
-		return nil
+		return err
 	}
 	stop()
 	return ec.AddOutputRows(ctx, rowsV.([]output.Row)...)
 }
 
+func offerFile(ctx context.Context, ec plug.ExecContext, name, path string) error {
+	count, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (int, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return 0, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.queue")
+		sp.SetText(fmt.Sprintf("Adding values into Queue '%s'", name))
+		var count int
+		batch := make([]hazelcast.Data, 0, addAllBatchSize)
+		flush := func() error {
+			if len(batch) == 0 {
+				return nil
+			}
+			resp, err := invoke(ctx, ci, name, codec.EncodeQueueAddAllRequest(name, batch))
+			if err != nil {
+				return err
+			}
+			if !codec.DecodeQueueAddAllResponse(resp) {
+				return fmt.Errorf("could not add all values to Queue '%s', the queue may be full", name)
+			}
+			count += len(batch)
+			batch = batch[:0]
+			sp.SetText(fmt.Sprintf("Added %d values into Queue '%s'", count, name))
+			return nil
+		}
+		err = commands.ReadValuesFile(path, func(v iserialization.JSON) error {
+			vd, err := ci.EncodeData(v)
+			if err != nil {
+				return err
+			}
+			batch = append(batch, vd)
+			if len(batch) < addAllBatchSize {
+				return nil
+			}
+			return flush()
+		})
+		if err != nil {
+			return count, err
+		}
+		return count, flush()
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	msg := fmt.Sprintf("OK Added %d values into Queue '%s'.", count, name)
+	ec.PrintlnUnnecessary(msg)
+	return nil
+}
+
 func init() {
 	check.Must(plug.Registry.RegisterCommand("queue:offer", &QueueOfferCommand{}))
 }
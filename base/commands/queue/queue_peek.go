@@ -0,0 +1,53 @@
+//go:build std || queue
+
+package queue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+type PeekCommand struct{}
+
+func (PeekCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("peek")
+	help := "Return the head of the given Queue without removing it"
+	cc.SetCommandHelp(help, help)
+	commands.AddValueTypeFlag(cc)
+	return nil
+}
+
+func (PeekCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	row, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (output.Row, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.queue")
+		sp.SetText(fmt.Sprintf("Peeking into Queue '%s'", name))
+		resp, err := invoke(ctx, ci, name, codec.EncodeQueuePeekRequest(name))
+		if err != nil {
+			return nil, err
+		}
+		return makeValueRow(ec, ci, codec.DecodeQueuePeekResponse(resp)), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return ec.AddOutputRows(ctx, row)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("queue:peek", &PeekCommand{}))
+}
@@ -5,6 +5,7 @@ package queue
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/hazelcast/hazelcast-commandline-client/base"
 	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
@@ -15,19 +16,21 @@ import (
 	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
-	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
 )
 
-const flagCount = "count"
-
 type PollCommand struct{}
 
 func (PollCommand) Init(cc plug.InitContext) error {
 	cc.SetCommandUsage("poll")
-	help := "Remove the given number of elements from the given Queue"
-	cc.SetCommandHelp(help, help)
+	long := `Remove the given number of elements from the given Queue
+
+If the timeout is not given, the available elements up to the given number are removed with a single request.
+If the timeout is given, each poll waits up to the timeout for an element to become available.`
+	short := "Remove the given number of elements from the given Queue"
+	cc.SetCommandHelp(long, short)
 	commands.AddValueTypeFlag(cc)
 	cc.AddIntFlag(flagCount, "", 1, false, "number of element to be removed from the given queue")
+	cc.AddIntFlag(flagTimeout, "", 0, false, "time to wait for an element to become available (ms)")
 	return nil
 }
 
@@ -37,6 +40,13 @@ func (PollCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 	if count < 0 {
 		return fmt.Errorf("%s cannot be negative", flagCount)
 	}
+	if count > math.MaxInt32 {
+		return fmt.Errorf("%s cannot be greater than %d", flagCount, math.MaxInt32)
+	}
+	timeout := ec.Props().GetInt(flagTimeout)
+	if timeout < 0 {
+		return fmt.Errorf("%s cannot be negative", flagTimeout)
+	}
 	rows, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
 		ci, err := cmd.ClientInternal(ctx, ec, sp)
 		if err != nil {
@@ -44,11 +54,20 @@ func (PollCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 		}
 		cmd.IncrementClusterMetric(ctx, ec, "total.queue")
 		sp.SetText(fmt.Sprintf("Polling from Queue '%s'", queueName))
-		req := codec.EncodeQueuePollRequest(queueName, 0)
 		pID, err := internal.StringToPartitionID(ci, queueName)
 		if err != nil {
 			return nil, err
 		}
+		if timeout == 0 {
+			req := codec.EncodeQueueDrainToMaxSizeRequest(queueName, int32(count))
+			resp, err := ci.InvokeOnPartition(ctx, req, pID, nil)
+			if err != nil {
+				return nil, err
+			}
+			return makeValueRows(ec, ci, codec.DecodeQueueDrainToMaxSizeResponse(resp)), nil
+		}
+		// each poll waits up to the timeout, so the elements are polled one at a time
+		req := codec.EncodeQueuePollRequest(queueName, timeout)
 		var rows []output.Row
 		for i := 0; i < count; i++ {
 			rv, err := ci.InvokeOnPartition(ctx, req, pID, nil)
@@ -56,27 +75,7 @@ func (PollCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 				return nil, err
 			}
 			data := codec.DecodeQueuePollResponse(rv)
-			vt := data.Type()
-			value, err := ci.DecodeData(data)
-			if err != nil {
-				ec.Logger().Info("The value was not decoded, due to error: %s", err.Error())
-				value = serialization.NondecodedType(serialization.TypeToLabel(vt))
-			}
-			row := output.Row{
-				output.Column{
-					Name:  output.NameValue,
-					Type:  vt,
-					Value: value,
-				},
-			}
-			if ec.Props().GetBool(base.FlagShowType) {
-				row = append(row, output.Column{
-					Name:  output.NameValueType,
-					Type:  serialization.TypeString,
-					Value: serialization.TypeToLabel(vt),
-				})
-			}
-			rows = append(rows, row)
+			rows = append(rows, makeValueRow(ec, ci, data))
 		}
 		return rows, nil
 	})
@@ -0,0 +1,12 @@
+//go:build std || schema
+
+package schema
+
+const (
+	argTypeName      = "typeName"
+	argTitleTypeName = "type name"
+	argPath          = "path"
+	argTitlePath     = "path"
+	flagOutputPath   = "output-path"
+	metricSchema     = "total.schema"
+)
@@ -0,0 +1,3 @@
+package schema
+
+// This file exists only for compilation
@@ -0,0 +1,39 @@
+//go:build std || schema
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc/paths"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+type Command struct{}
+
+func (Command) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("schema")
+	cc.SetTopLevel(true)
+	long := fmt.Sprintf(`Compact schema operations
+
+The Compact schemas of the values decoded by CLC are saved to the local schema registry at %s.
+`, paths.Schemas())
+	short := "Compact schema operations"
+	cc.SetCommandHelp(long, short)
+	return nil
+}
+
+func (Command) Exec(context.Context, plug.ExecContext) error {
+	return nil
+}
+
+func registry() serialization.SchemaRegistry {
+	return serialization.NewSchemaRegistry(paths.Schemas())
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("schema", &Command{}))
+}
@@ -0,0 +1,92 @@
+//go:build std || schema
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+type ExportCommand struct{}
+
+func (ExportCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("export")
+	long := `Exports the Compact schemas in the local schema registry
+
+The latest version of each type is exported in the Compact schema file format.
+All types are exported if no type names are given.
+The type names of the nested Compact fields are not known, so they should be added to the exported file before using it in the configuration.
+The schemas are written to the standard output, unless an output path is given.
+`
+	short := "Exports the Compact schemas in the local schema registry"
+	cc.SetCommandHelp(long, short)
+	cc.AddStringFlag(flagOutputPath, "o", "", false, "path of the file to write the schemas to")
+	cc.AddStringSliceArg(argTypeName, argTitleTypeName, 0, clc.MaxArgs)
+	return nil
+}
+
+func (ExportCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	cmd.IncrementMetric(ctx, ec, metricSchema)
+	typeNames := ec.GetStringSliceArg(argTypeName)
+	schemas, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) ([]serialization.CompactSchema, error) {
+		sp.SetText("Finding schemas")
+		rs, err := registry().Latest()
+		if err != nil {
+			return nil, err
+		}
+		return selectSchemas(rs, typeNames)
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	b, err := serialization.MarshalCompactSchemas(schemas)
+	if err != nil {
+		return err
+	}
+	path := ec.Props().GetString(flagOutputPath)
+	if path == "" {
+		_, err = ec.Stdout().Write(b)
+		return err
+	}
+	if err := os.WriteFile(path, b, 0600); err != nil {
+		return err
+	}
+	ec.PrintlnUnnecessary(fmt.Sprintf("OK Exported %d schema(s) to %s.", len(schemas), path))
+	return nil
+}
+
+// selectSchemas returns the schemas with the given type names, or all schemas if no type names are given.
+func selectSchemas(rs []serialization.RegisteredSchema, typeNames []string) ([]serialization.CompactSchema, error) {
+	byName := make(map[string]serialization.CompactSchema, len(rs))
+	for _, s := range rs {
+		byName[s.TypeName] = s.CompactSchema
+	}
+	if len(typeNames) == 0 {
+		schemas := make([]serialization.CompactSchema, len(rs))
+		for i, s := range rs {
+			schemas[i] = s.CompactSchema
+		}
+		return schemas, nil
+	}
+	schemas := make([]serialization.CompactSchema, len(typeNames))
+	for i, tn := range typeNames {
+		s, ok := byName[tn]
+		if !ok {
+			return nil, fmt.Errorf("no schemas found for type: %s", tn)
+		}
+		schemas[i] = s
+	}
+	return schemas, nil
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("schema:export", &ExportCommand{}))
+}
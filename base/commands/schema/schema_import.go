@@ -0,0 +1,84 @@
+//go:build std || schema
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec/control"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+type ImportCommand struct{}
+
+func (ImportCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("import")
+	long := `Registers the Compact schemas in the given schema files with the cluster
+
+The schema files have the same format with the files exported using the schema export command.
+The imported schemas are also saved to the local schema registry.
+`
+	short := "Registers the Compact schemas in the given schema files with the cluster"
+	cc.SetCommandHelp(long, short)
+	cc.AddStringSliceArg(argPath, argTitlePath, 1, clc.MaxArgs)
+	return nil
+}
+
+func (ImportCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	var schemas []serialization.CompactSchema
+	for _, p := range ec.GetStringSliceArg(argPath) {
+		ss, err := serialization.LoadCompactSchemas(p)
+		if err != nil {
+			return err
+		}
+		schemas = append(schemas, ss...)
+	}
+	_, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, metricSchema)
+		sp.SetText(fmt.Sprintf("Registering %d schema(s)", len(schemas)))
+		req := codec.EncodeClientSendAllSchemasRequest(controlSchemas(schemas))
+		if _, err := ci.InvokeOnRandomTarget(ctx, req, nil); err != nil {
+			return nil, err
+		}
+		r := registry()
+		for _, s := range schemas {
+			if _, err := r.Save(s); err != nil {
+				ec.Logger().Warn("Could not save the schema of %s to the local schema registry: %s", s.TypeName, err.Error())
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	ec.PrintlnUnnecessary(fmt.Sprintf("OK Imported %d schema(s).", len(schemas)))
+	return nil
+}
+
+func controlSchemas(schemas []serialization.CompactSchema) []control.Schema {
+	cs := make([]control.Schema, len(schemas))
+	for i, s := range schemas {
+		fds := make([]control.FieldDescriptor, len(s.Fields))
+		for j, f := range s.Fields {
+			kind, _ := serialization.CompactFieldKind(f.Kind)
+			fds[j] = control.FieldDescriptor{Name: f.Name, Kind: int32(kind)}
+		}
+		cs[i] = control.Schema{TypeName: s.TypeName, Fields: fds}
+	}
+	return cs
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("schema:import", &ImportCommand{}))
+}
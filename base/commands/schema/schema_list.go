@@ -0,0 +1,82 @@
+//go:build std || schema
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/types"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+type ListCommand struct{}
+
+func (ListCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("list")
+	long := `Lists the Compact schemas in the local schema registry
+
+Each version of a Compact type is listed separately.
+`
+	short := "Lists the Compact schemas in the local schema registry"
+	cc.SetCommandHelp(long, short)
+	return nil
+}
+
+func (ListCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	cmd.IncrementMetric(ctx, ec, metricSchema)
+	rows, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) ([]output.Row, error) {
+		sp.SetText("Finding schemas")
+		rs, err := registry().Schemas()
+		if err != nil {
+			return nil, err
+		}
+		rows := make([]output.Row, len(rs))
+		for i, s := range rs {
+			rows[i] = output.Row{
+				output.Column{
+					Name:  "Type Name",
+					Type:  serialization.TypeString,
+					Value: s.TypeName,
+				},
+				output.Column{
+					Name:  "Schema ID",
+					Type:  serialization.TypeInt64,
+					Value: s.ID,
+				},
+				output.Column{
+					Name:  "Fields",
+					Type:  serialization.TypeInt32,
+					Value: int32(len(s.Fields)),
+				},
+				output.Column{
+					Name:  "Saved At",
+					Type:  serialization.TypeJavaLocalDateTime,
+					Value: types.LocalDateTime(s.SavedAt),
+				},
+			}
+		}
+		return rows, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	if len(rows) == 0 {
+		ec.PrintlnUnnecessary("OK No schemas found.")
+		return nil
+	}
+	msg := fmt.Sprintf("OK Found %d schema(s).", len(rows))
+	defer ec.PrintlnUnnecessary(msg)
+	return ec.AddOutputRows(ctx, rows...)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("schema:list", &ListCommand{}))
+}
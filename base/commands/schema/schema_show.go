@@ -0,0 +1,77 @@
+//go:build std || schema
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+type ShowCommand struct{}
+
+func (ShowCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("show")
+	long := `Shows the fields of the given Compact type in the local schema registry
+
+If there are more than one versions of the type, the fields of all versions are shown, starting with the oldest one.
+Use the --format flag to output the fields as JSON.
+`
+	short := "Shows the fields of the given Compact type"
+	cc.SetCommandHelp(long, short)
+	cc.AddStringArg(argTypeName, argTitleTypeName)
+	return nil
+}
+
+func (ShowCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	cmd.IncrementMetric(ctx, ec, metricSchema)
+	typeName := ec.GetStringArg(argTypeName)
+	rows, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) ([]output.Row, error) {
+		sp.SetText(fmt.Sprintf("Finding the schemas of %s", typeName))
+		rs, err := registry().Find(typeName)
+		if err != nil {
+			return nil, err
+		}
+		if len(rs) == 0 {
+			return nil, fmt.Errorf("no schemas found for type: %s", typeName)
+		}
+		var rows []output.Row
+		for _, s := range rs {
+			for _, f := range s.Fields {
+				rows = append(rows, output.Row{
+					output.Column{
+						Name:  "Schema ID",
+						Type:  serialization.TypeInt64,
+						Value: s.ID,
+					},
+					output.Column{
+						Name:  "Field",
+						Type:  serialization.TypeString,
+						Value: f.Name,
+					},
+					output.Column{
+						Name:  "Kind",
+						Type:  serialization.TypeString,
+						Value: f.Kind,
+					},
+				})
+			}
+		}
+		return rows, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return ec.AddOutputRows(ctx, rows...)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("schema:show", &ShowCommand{}))
+}
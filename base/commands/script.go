@@ -38,6 +38,11 @@ The script can contain:
 	2. CLC commands prefixed with backslash.
 	3. Comments starting with -- (double dash)
 
+${NAME} in the script is replaced with the value of the variable NAME.
+The variables can be given with the --var flag, or set in the script with the \set and \setq commands.
+If a variable is not set, the environment variable with the same name is used.
+Use $${ for a literal ${.
+
 The script should have either .clc or .sql extension.
 Files with one of these two extensions are interpreted equivalently.
 	
@@ -47,6 +52,7 @@ See examples/sql/dessert.sql for a sample script.
 	cc.SetCommandHelp(long, short)
 	cc.AddBoolFlag(flagIgnoreErrors, "", false, false, "ignore errors during script execution")
 	cc.AddBoolFlag(flagEcho, "", false, false, "print the executed command")
+	cc.AddStringSliceFlag(flagVar, "", false, "variable in the `NAME=VALUE` format; can be given more than once")
 	cc.AddStringSliceArg(argPath, argTitlePath, 0, 1)
 	return nil
 }
@@ -68,13 +74,17 @@ func (ScriptCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 		Stderr: ec.Stderr(),
 		Stdout: ec.Stdout(),
 	}
+	vars, err := scriptVariables(ec)
+	if err != nil {
+		return err
+	}
 	m, err := ec.(*cmd.ExecContext).Main().Clone(plug.ModeScripting)
 	if err != nil {
 		return fmt.Errorf("cloning Main: %w", err)
 	}
 	ie := ec.Props().GetBool(flagIgnoreErrors)
 	echo := ec.Props().GetBool(flagEcho)
-	textFn := makeTextFunc(m, ec, func(shortcut string) bool {
+	textFn := makeTextFunc(m, ec, vars, func(shortcut string) bool {
 		// shortcuts are not supported in the script mode
 		return false
 	})
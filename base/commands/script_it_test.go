@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/map"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/it"
 )
@@ -18,6 +20,7 @@ func TestScript(t *testing.T) {
 	}{
 		{name: "script_Interactive", f: script_InteractiveTest},
 		{name: "script_NonInteractive", f: script_NonInteractiveTest},
+		{name: "scriptVariables_NonInteractive", f: scriptVariables_NonInteractiveTest},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, tc.f)
@@ -34,6 +37,21 @@ func script_NonInteractiveTest(t *testing.T) {
 	})
 }
 
+func scriptVariables_NonInteractiveTest(t *testing.T) {
+	ctx := context.TODO()
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		tcx.WithReset(func() {
+			tcx.CLCExecute(ctx, "script", "testdata/test-variables.clc", "--var", "env=staging")
+			tcx.AssertStdoutContains("Hello from staging\nanswer: 42\n")
+		})
+		tcx.WithReset(func() {
+			err := tcx.CLCExecuteErr(ctx, "script", "testdata/test-variables.clc")
+			require.EqualError(t, err, "undefined variable: env")
+		})
+	})
+}
+
 func script_InteractiveTest(t *testing.T) {
 	ctx := context.TODO()
 	tcx := it.TestContext{T: t}
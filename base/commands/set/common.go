@@ -9,8 +9,10 @@ import (
 	"github.com/hazelcast/hazelcast-go-client"
 
 	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
 	"github.com/hazelcast/hazelcast-commandline-client/clc"
 	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
 )
 
@@ -23,3 +25,24 @@ func getSet(ctx context.Context, ec plug.ExecContext, sp clc.Spinner) (*hazelcas
 	sp.SetText(fmt.Sprintf("Getting Set '%s'", name))
 	return ci.Client().GetSet(ctx, name)
 }
+
+// invoke sends the request to the partition which owns the set.
+func invoke(ctx context.Context, ci *hazelcast.ClientInternal, name string, req *hazelcast.ClientMessage) (*hazelcast.ClientMessage, error) {
+	pID, err := internal.StringToPartitionID(ci, name)
+	if err != nil {
+		return nil, err
+	}
+	return ci.InvokeOnPartition(ctx, req, pID, nil)
+}
+
+func makeValueDatas(ctx context.Context, ec plug.ExecContext, ci *hazelcast.ClientInternal, values []string) ([]hazelcast.Data, error) {
+	ds := make([]hazelcast.Data, len(values))
+	for i, v := range values {
+		vd, err := commands.MakeValueData(ctx, ec, ci, v)
+		if err != nil {
+			return nil, err
+		}
+		ds[i] = vd
+	}
+	return ds, nil
+}
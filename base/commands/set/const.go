@@ -0,0 +1,9 @@
+//go:build std || set
+
+package set
+
+const (
+	flagFile = "file"
+	// addAllBatchSize is the maximum number of values sent to the cluster in a single AddAll request.
+	addAllBatchSize = 1000
+)
@@ -42,7 +42,7 @@ func (AddCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 		sp.SetText(fmt.Sprintf("Adding values into Set '%s'", name))
 		var rows []output.Row
 		for _, arg := range ec.GetStringSliceArg(base.ArgValue) {
-			vd, err := commands.MakeValueData(ec, ci, arg)
+			vd, err := commands.MakeValueData(ctx, ec, ci, arg)
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,133 @@
+//go:build std || set
+
+package set
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	iserialization "github.com/hazelcast/hazelcast-go-client/serialization"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+type AddAllCommand struct{}
+
+func (AddAllCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("add-all")
+	long := `Add all of the values to the given Set
+
+The values can be given as arguments or read from a file using the --file flag.
+` + commands.ValuesFileHelp
+	short := "Add all of the values to the given Set"
+	cc.SetCommandHelp(long, short)
+	commands.AddValueTypeFlag(cc)
+	cc.AddStringFlag(flagFile, "", "", false, "CSV or NDJSON file to read the values from")
+	cc.AddStringSliceArg(base.ArgValue, base.ArgTitleValue, 0, clc.MaxArgs)
+	return nil
+}
+
+func (AddAllCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	path := ec.Props().GetString(flagFile)
+	values := ec.GetStringSliceArg(base.ArgValue)
+	if path != "" && len(values) > 0 {
+		return fmt.Errorf("values cannot be given together with --%s", flagFile)
+	}
+	if path == "" && len(values) == 0 {
+		return fmt.Errorf("either values or --%s must be given", flagFile)
+	}
+	if path != "" {
+		if err := commands.CheckValuesFileValueType(ec, flagFile); err != nil {
+			return err
+		}
+	}
+	type result struct {
+		count   int
+		changed bool
+	}
+	r, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (result, error) {
+		var r result
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return r, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.set")
+		// get the set just to ensure the corresponding proxy is created
+		if _, err := getSet(ctx, ec, sp); err != nil {
+			return r, err
+		}
+		sp.SetText(fmt.Sprintf("Adding values into Set '%s'", name))
+		batch := make([]hazelcast.Data, 0, addAllBatchSize)
+		flush := func() error {
+			if len(batch) == 0 {
+				return nil
+			}
+			resp, err := invoke(ctx, ci, name, codec.EncodeSetAddAllRequest(name, batch))
+			if err != nil {
+				return err
+			}
+			r.changed = codec.DecodeSetAddAllResponse(resp) || r.changed
+			r.count += len(batch)
+			batch = batch[:0]
+			sp.SetText(fmt.Sprintf("Added %d values into Set '%s'", r.count, name))
+			return nil
+		}
+		add := func(vd hazelcast.Data) error {
+			batch = append(batch, vd)
+			if len(batch) < addAllBatchSize {
+				return nil
+			}
+			return flush()
+		}
+		if path != "" {
+			err = commands.ReadValuesFile(path, func(v iserialization.JSON) error {
+				vd, err := ci.EncodeData(v)
+				if err != nil {
+					return err
+				}
+				return add(vd)
+			})
+		} else {
+			for _, arg := range values {
+				var vd hazelcast.Data
+				if vd, err = commands.MakeValueData(ctx, ec, ci, arg); err != nil {
+					break
+				}
+				if err = add(vd); err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return r, err
+		}
+		return r, flush()
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	msg := fmt.Sprintf("OK Added %d values into Set '%s'.", r.count, name)
+	ec.PrintlnUnnecessary(msg)
+	return ec.AddOutputRows(ctx, output.Row{
+		{
+			Name:  "Changed",
+			Type:  serialization.TypeBool,
+			Value: r.changed,
+		},
+	})
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("set:add-all", &AddAllCommand{}))
+}
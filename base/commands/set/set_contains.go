@@ -0,0 +1,94 @@
+//go:build std || set
+
+package set
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+type ContainsCommand struct {
+	all bool
+}
+
+func (cm ContainsCommand) Init(cc plug.InitContext) error {
+	if cm.all {
+		cc.SetCommandUsage("contains-all")
+		help := "Check if all of the values are present in the given Set"
+		cc.SetCommandHelp(help, help)
+		cc.AddStringSliceArg(base.ArgValue, base.ArgTitleValue, 1, clc.MaxArgs)
+	} else {
+		cc.SetCommandUsage("contains")
+		help := "Check if the value is present in the given Set"
+		cc.SetCommandHelp(help, help)
+		cc.AddStringArg(base.ArgValue, base.ArgTitleValue)
+	}
+	commands.AddValueTypeFlag(cc)
+	return nil
+}
+
+func (cm ContainsCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	name := ec.Props().GetString(base.FlagName)
+	ok, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (bool, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return false, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.set")
+		// get the set just to ensure the corresponding proxy is created
+		if _, err := getSet(ctx, ec, sp); err != nil {
+			return false, err
+		}
+		var req *hazelcast.ClientMessage
+		if cm.all {
+			ds, err := makeValueDatas(ctx, ec, ci, ec.GetStringSliceArg(base.ArgValue))
+			if err != nil {
+				return false, err
+			}
+			req = codec.EncodeSetContainsAllRequest(name, ds)
+		} else {
+			vd, err := commands.MakeValueData(ctx, ec, ci, ec.GetStringArg(base.ArgValue))
+			if err != nil {
+				return false, err
+			}
+			req = codec.EncodeSetContainsRequest(name, vd)
+		}
+		sp.SetText(fmt.Sprintf("Checking if the values exist in Set '%s'", name))
+		resp, err := invoke(ctx, ci, name, req)
+		if err != nil {
+			return false, err
+		}
+		if cm.all {
+			return codec.DecodeSetContainsAllResponse(resp), nil
+		}
+		return codec.DecodeSetContainsResponse(resp), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return ec.AddOutputRows(ctx, output.Row{
+		{
+			Name:  "Contains",
+			Type:  serialization.TypeBool,
+			Value: ok,
+		},
+	})
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("set:contains", &ContainsCommand{}))
+	check.Must(plug.Registry.RegisterCommand("set:contains-all", &ContainsCommand{all: true}))
+}
@@ -51,7 +51,7 @@ func (GetAllCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 			val, err := ci.DecodeData(*r)
 			if err != nil {
 				ec.Logger().Info("The value was not decoded, due to error: %s", err.Error())
-				val = serialization.NondecodedType(serialization.TypeToLabel(r.Type()))
+				val = serialization.NondecodedValue(*r, ec.Props().GetBool(base.FlagRaw))
 			}
 			row := output.Row{
 				{
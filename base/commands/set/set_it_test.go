@@ -4,7 +4,10 @@ package set_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
 	"github.com/hazelcast/hazelcast-go-client/types"
@@ -21,6 +24,11 @@ func TestSet(t *testing.T) {
 		f    func(t *testing.T)
 	}{
 		{name: "Add_NonInteractive", f: add_NonInteractiveTest},
+		{name: "AddAll_NonInteractive", f: addAll_NonInteractiveTest},
+		{name: "AddAllFile_NonInteractive", f: addAllFile_NonInteractiveTest},
+		{name: "Contains_NonInteractive", f: contains_NonInteractiveTest},
+		{name: "ContainsAll_NonInteractive", f: containsAll_NonInteractiveTest},
+		{name: "Listen_NonInteractive", f: listen_NonInteractiveTest},
 		{name: "Remove_Noninteractive", f: remove_NonInteractiveTest},
 		{name: "Clear_NonInteractive", f: clear_NonInteractiveTest},
 		{name: "Size_Interactive", f: size_InteractiveTest},
@@ -32,6 +40,82 @@ func TestSet(t *testing.T) {
 	}
 }
 
+func addAll_NonInteractiveTest(t *testing.T) {
+	it.SetTester(t, func(tcx it.TestContext, s *hz.Set) {
+		t := tcx.T
+		ctx := context.Background()
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "set", "-n", s.Name(), "add-all", "foo", "bar", "foo", "-q"))
+			tcx.AssertStdoutEquals("true\n")
+			require.Equal(t, 2, check.MustValue(s.Size(ctx)))
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "set", "-n", s.Name(), "add-all", "foo", "-q"))
+			tcx.AssertStdoutEquals("false\n")
+		})
+	})
+}
+
+func addAllFile_NonInteractiveTest(t *testing.T) {
+	it.SetTester(t, func(tcx it.TestContext, s *hz.Set) {
+		t := tcx.T
+		ctx := context.Background()
+		path := filepath.Join(t.TempDir(), "values.ndjson")
+		check.Must(os.WriteFile(path, []byte("{\"id\": 1}\n{\"id\": 2}\n"), 0600))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "set", "-n", s.Name(), "add-all", "--file", path))
+			tcx.AssertStdoutContains("OK Added 2 values")
+			require.Equal(t, 2, check.MustValue(s.Size(ctx)))
+		})
+	})
+}
+
+func contains_NonInteractiveTest(t *testing.T) {
+	it.SetTester(t, func(tcx it.TestContext, s *hz.Set) {
+		ctx := context.Background()
+		check.MustValue(s.Add(ctx, "foo"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "set", "-n", s.Name(), "contains", "foo", "-q"))
+			tcx.AssertStdoutEquals("true\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "set", "-n", s.Name(), "contains", "bar", "-q"))
+			tcx.AssertStdoutEquals("false\n")
+		})
+	})
+}
+
+func containsAll_NonInteractiveTest(t *testing.T) {
+	it.SetTester(t, func(tcx it.TestContext, s *hz.Set) {
+		ctx := context.Background()
+		check.MustValue(s.AddAll(ctx, "foo", "bar"))
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "set", "-n", s.Name(), "contains-all", "foo", "bar", "-q"))
+			tcx.AssertStdoutEquals("true\n")
+		})
+		tcx.WithReset(func() {
+			check.Must(tcx.CLC().Execute(ctx, "set", "-n", s.Name(), "contains-all", "foo", "baz", "-q"))
+			tcx.AssertStdoutEquals("false\n")
+		})
+	})
+}
+
+func listen_NonInteractiveTest(t *testing.T) {
+	it.SetTester(t, func(tcx it.TestContext, s *hz.Set) {
+		ctx := context.Background()
+		tcx.WithReset(func() {
+			go func() {
+				check.Must(tcx.CLC().Execute(ctx, "set", "-n", s.Name(), "listen", "--count", "2"))
+			}()
+			time.Sleep(1 * time.Second)
+			check.MustValue(s.Add(ctx, "foo"))
+			check.MustValue(s.Remove(ctx, "foo"))
+			tcx.AssertStdoutContains("ADDED")
+			tcx.AssertStdoutContains("REMOVED")
+		})
+	})
+}
+
 func getAll_NonInteractiveTest(t *testing.T) {
 	it.SetTester(t, func(tcx it.TestContext, s *hz.Set) {
 		t := tcx.T
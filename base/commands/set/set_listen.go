@@ -0,0 +1,19 @@
+//go:build std || set
+
+package set
+
+import (
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+func init() {
+	c := commands.NewItemListenCommand("Set", "set", commands.ItemListenerCodecs{
+		EncodeAdd:    codec.EncodeSetAddListenerRequest,
+		EncodeRemove: codec.EncodeSetRemoveListenerRequest,
+		Handle:       codec.HandleSetAddListener,
+	})
+	check.Must(plug.Registry.RegisterCommand("set:listen", c))
+}
@@ -41,7 +41,7 @@ func (sc *RemoveCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 		showType := ec.Props().GetBool(base.FlagShowType)
 		var rows []output.Row
 		for _, arg := range ec.GetStringSliceArg(base.ArgValue) {
-			vd, err := commands.MakeValueData(ec, ci, arg)
+			vd, err := commands.MakeValueData(ctx, ec, ci, arg)
 			if err != nil {
 				return nil, err
 			}
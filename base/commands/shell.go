@@ -5,6 +5,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"io"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -13,6 +14,7 @@ import (
 	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
 	"github.com/hazelcast/hazelcast-commandline-client/clc/paths"
 	"github.com/hazelcast/hazelcast-commandline-client/clc/shell"
+	clcsql "github.com/hazelcast/hazelcast-commandline-client/clc/sql"
 	puberrors "github.com/hazelcast/hazelcast-commandline-client/errors"
 	"github.com/hazelcast/hazelcast-commandline-client/internal"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
@@ -45,10 +47,14 @@ func (cm *ShellCommand) Init(cc plug.InitContext) error {
 	cc.Hide()
 	cm.mu.Lock()
 	cm.shortcuts = map[string]struct{}{
-		`\di`:   {},
-		`\dm`:   {},
-		`\dm+`:  {},
-		`\exit`: {},
+		`\di`:            {},
+		`\dm`:            {},
+		`\dm+`:           {},
+		`\exit`:          {},
+		shell.CmdExplain: {},
+	}
+	for _, name := range shell.DescribeCommandNames() {
+		cm.shortcuts[name] = struct{}{}
 	}
 	cm.mu.Unlock()
 	return nil
@@ -84,7 +90,7 @@ func (cm *ShellCommand) ExecInteractive(ctx context.Context, ec plug.ExecContext
 		}
 	}
 	endLineFn := makeEndLineFunc()
-	textFn := makeTextFunc(m, ec, func(shortcut string) bool {
+	textFn := makeTextFunc(m, ec, shell.NewVariables(), func(shortcut string) bool {
 		cm.mu.RLock()
 		_, ok := cm.shortcuts[shortcut]
 		cm.mu.RUnlock()
@@ -114,15 +120,40 @@ func (cm *ShellCommand) ExecInteractive(ctx context.Context, ec plug.ExecContext
 		pd := paths.ParentDir(cfgPath)
 		return fmt.Sprintf("%s %s ", str.MaybeShorten(pd, 12), defaultPrompt)
 	}
-	sh, err := shell.New(promptFn, " ... ", path, ec.Stdout(), ec.Stderr(), ec.Stdin(), endLineFn, textFn)
+	mappings := shell.NewMappingCache(func(ctx context.Context) (map[string][]string, error) {
+		return clcsql.Mappings(ctx, ec)
+	})
+	sqlTextFn := func(ctx context.Context, stdout io.Writer, text string) error {
+		if err := textFn(ctx, stdout, text); err != nil {
+			return err
+		}
+		// the mappings may have changed
+		if clcsql.IsDDL(text) {
+			mappings.Invalidate()
+		}
+		return nil
+	}
+	sh, err := shell.New(promptFn, " ... ", path, ec.Stdout(), ec.Stderr(), ec.Stdin(), endLineFn, sqlTextFn)
 	if err != nil {
 		return err
 	}
 	sh.SetCommentPrefix("--")
+	sh.SetCompleter(shell.NewCompleter(m.Root(), mappings, cm.shortcutNames()))
 	defer sh.Close()
 	return sh.Start(ctx)
 }
 
+// shortcutNames returns the names of the shortcut commands, including the ones handled by the shell.
+func (cm *ShellCommand) shortcutNames() []string {
+	cm.mu.RLock()
+	names := make([]string, 0, len(cm.shortcuts)+8)
+	for name := range cm.shortcuts {
+		names = append(names, name)
+	}
+	cm.mu.RUnlock()
+	return append(names, shell.CmdBind, shell.CmdEcho, shell.CmdSet, shell.CmdSetQ, shell.CmdTiming, shell.CmdUnset, shell.CmdWatch, shell.CmdPrefix+"help")
+}
+
 func init() {
 	check.Must(plug.Registry.RegisterCommand("shell", &ShellCommand{}))
 }
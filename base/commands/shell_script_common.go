@@ -7,12 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/shlex"
 
 	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
 	"github.com/hazelcast/hazelcast-commandline-client/clc/shell"
+	clcsql "github.com/hazelcast/hazelcast-commandline-client/clc/sql"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
 )
@@ -47,10 +50,69 @@ func makeEndLineFunc() shell.EndLineFn {
 	}
 }
 
-func makeTextFunc(m *cmd.Main, ec plug.ExecContext, sf shortcutFunc) shell.TextFn {
+func makeTextFunc(m *cmd.Main, ec plug.ExecContext, vars *shell.Variables, sf shortcutFunc) shell.TextFn {
+	// params are the parameters bound with the \bind command for the next query
+	var params []any
+	// timing is toggled with the \timing command
+	var timing bool
+	// lastQuery and lastParams are the last executed query which returns rows and its parameters,
+	// they are used by the \watch command
+	var lastQuery string
+	var lastParams []any
 	return func(ctx context.Context, stdout io.Writer, text string) error {
+		// the variables are replaced both in the queries and the commands
+		text, err := vars.Expand(text)
+		if err != nil {
+			return err
+		}
 		if strings.HasPrefix(strings.TrimSpace(text), shell.CmdPrefix) {
 			parts := strings.Fields(text)
+			switch parts[0] {
+			case shell.CmdSet:
+				// \set is also the command for the Set data structure, e.g., \set add -n myset foo,
+				// which is run below as a CLC command
+				if !isSetDSCommand(m, parts) {
+					return setVariable(ctx, ec, vars, text)
+				}
+			case shell.CmdUnset:
+				return unsetVariable(vars, text)
+			case shell.CmdEcho:
+				return echo(stdout, text)
+			case shell.CmdSetQ:
+				ps := params
+				params = nil
+				return setQueryVariable(ctx, ec, vars, text, ps...)
+			case shell.CmdBind:
+				var err error
+				params, err = bindParams(text)
+				return err
+			case shell.CmdTiming:
+				if len(parts) != 1 {
+					return fmt.Errorf("Usage: %s", shell.CmdTiming)
+				}
+				timing = !timing
+				if timing {
+					ec.PrintlnUnnecessary("OK Timing is on.")
+				} else {
+					ec.PrintlnUnnecessary("OK Timing is off.")
+				}
+				return nil
+			case shell.CmdWatch:
+				interval, query, err := watchArgs(text)
+				if err != nil {
+					return err
+				}
+				ps := params
+				params = nil
+				if query == "" {
+					if lastQuery == "" {
+						return errors.New("there is no query to watch")
+					}
+					query, ps = lastQuery, lastParams
+				}
+				opts := clcsql.WatchOptions{Interval: interval, MaxRows: clcsql.DefaultWatchRows}
+				return clcsql.Watch(ctx, ec, query, opts, ps...)
+			}
 			ok := sf(parts[0])
 			if !ok {
 				// this is a CLC command
@@ -64,7 +126,14 @@ func makeTextFunc(m *cmd.Main, ec plug.ExecContext, sf shortcutFunc) shell.TextF
 				return m.Execute(ctx, args...)
 			}
 		}
-		f, err := shell.ConvertStatement(ctx, ec, text)
+		// only the queries which return rows can be watched
+		if t := strings.TrimSpace(text); clcsql.IsQuery(t) {
+			lastQuery = strings.TrimSuffix(t, ";")
+			lastParams = params
+		}
+		f, err := shell.ConvertStatement(ctx, ec, text, timing, params...)
+		// the bound parameters are used only for a single query
+		params = nil
 		if err != nil {
 			if errors.Is(err, shell.ErrHelp) {
 				check.I2(fmt.Fprintln(stdout, shell.InteractiveHelp()))
@@ -75,3 +144,30 @@ func makeTextFunc(m *cmd.Main, ec plug.ExecContext, sf shortcutFunc) shell.TextF
 		return f()
 	}
 }
+
+// bindParams returns the parameters in the given \bind command.
+// The parameters are in the TYPE:VALUE format, and they may be quoted.
+func bindParams(text string) ([]any, error) {
+	args, err := shlex.Split(strings.TrimSpace(text))
+	if err != nil {
+		return nil, err
+	}
+	return clcsql.ParseParams(args[1:])
+}
+
+// watchArgs returns the interval and the query in the given \watch command.
+// The query is empty if it is not given.
+func watchArgs(text string) (time.Duration, string, error) {
+	text = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(text), shell.CmdWatch))
+	interval := clcsql.DefaultWatchInterval
+	if fs := strings.Fields(text); len(fs) > 0 {
+		if secs, err := strconv.ParseFloat(fs[0], 64); err == nil {
+			if secs <= 0 {
+				return 0, "", fmt.Errorf("Usage: %s [SECONDS] [QUERY]", shell.CmdWatch)
+			}
+			interval = time.Duration(secs * float64(time.Second))
+			text = strings.TrimSpace(strings.TrimPrefix(text, fs[0]))
+		}
+	}
+	return interval, strings.TrimSuffix(text, ";"), nil
+}
@@ -0,0 +1,62 @@
+//go:build std || script || shell
+
+package commands
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchArgs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		interval time.Duration
+		query    string
+	}{
+		{name: "no args", text: `\watch`, interval: 2 * time.Second},
+		{name: "interval", text: `\watch 5`, interval: 5 * time.Second},
+		{name: "fractional interval", text: `\watch 0.5`, interval: 500 * time.Millisecond},
+		{name: "interval and query", text: `\watch 10 SELECT * FROM cities;`, interval: 10 * time.Second, query: "SELECT * FROM cities"},
+		{name: "query", text: `\watch SELECT 1`, interval: 2 * time.Second, query: "SELECT 1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			interval, query, err := watchArgs(tc.text)
+			require.NoError(t, err)
+			require.Equal(t, tc.interval, interval)
+			require.Equal(t, tc.query, query)
+		})
+	}
+	_, _, err := watchArgs(`\watch 0`)
+	require.EqualError(t, err, `Usage: \watch [SECONDS] [QUERY]`)
+}
+
+func TestCommandNameValue(t *testing.T) {
+	testCases := []struct {
+		name  string
+		text  string
+		vName string
+		value string
+	}{
+		{name: "no name", text: `\set`},
+		{name: "name", text: `\set city`, vName: "city"},
+		{name: "value", text: `\set city London`, vName: "city", value: "London"},
+		{name: "value with spaces", text: "\\set  city \t New York ", vName: "city", value: "New York"},
+		{name: "single quoted value", text: `\set city 'New York'`, vName: "city", value: "New York"},
+		{name: "double quoted value", text: `\set filter "name = 'London'"`, vName: "filter", value: "name = 'London'"},
+		{name: "quoted query", text: `\setq total 'SELECT COUNT(*) FROM cities WHERE name = 'London''`, vName: "total", value: "SELECT COUNT(*) FROM cities WHERE name = 'London'"},
+		{name: "single quote", text: `\set q '`, vName: "q", value: "'"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := strings.Fields(tc.text)[0]
+			name, value := commandNameValue(cmd, tc.text)
+			require.Equal(t, tc.vName, name)
+			require.Equal(t, tc.value, value)
+		})
+	}
+}
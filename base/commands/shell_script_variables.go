@@ -0,0 +1,139 @@
+//go:build std || script || shell
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/shell"
+	clcsql "github.com/hazelcast/hazelcast-commandline-client/clc/sql"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+const flagVar = "var"
+
+// setVariable handles the \set command.
+// The variables are listed if no name is given.
+func setVariable(ctx context.Context, ec plug.ExecContext, vars *shell.Variables, text string) error {
+	name, value := commandNameValue(shell.CmdSet, text)
+	if name == "" {
+		return listVariables(ctx, ec, vars)
+	}
+	return vars.Set(name, value)
+}
+
+// isSetDSCommand returns true if the \set command with the given parts is a command for the Set data structure.
+// That is the case if the first argument is a flag or a subcommand of the Set command.
+func isSetDSCommand(m *cmd.Main, parts []string) bool {
+	if len(parts) < 2 {
+		return false
+	}
+	if strings.HasPrefix(parts[1], "-") {
+		return true
+	}
+	c, _, err := m.Root().Find(parts[:2])
+	return err == nil && c.Name() == parts[1]
+}
+
+// unsetVariable handles the \unset command.
+func unsetVariable(vars *shell.Variables, text string) error {
+	name, value := commandNameValue(shell.CmdUnset, text)
+	if name == "" || value != "" {
+		return fmt.Errorf("Usage: %s NAME", shell.CmdUnset)
+	}
+	vars.Unset(name)
+	return nil
+}
+
+// echo handles the \echo command.
+func echo(stdout io.Writer, text string) error {
+	text = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(text), shell.CmdEcho))
+	check.I2(fmt.Fprintln(stdout, unquote(text)))
+	return nil
+}
+
+// setQueryVariable handles the \setq command.
+// The variable is set to the first column of the first row of the query.
+func setQueryVariable(ctx context.Context, ec plug.ExecContext, vars *shell.Variables, text string, params ...any) error {
+	name, query := commandNameValue(shell.CmdSetQ, text)
+	query = strings.TrimSuffix(query, ";")
+	if name == "" || query == "" {
+		return fmt.Errorf("Usage: %s NAME QUERY", shell.CmdSetQ)
+	}
+	value, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) (string, error) {
+		if _, err := cmd.ClientInternal(ctx, ec, sp); err != nil {
+			return "", err
+		}
+		sp.SetText("Executing SQL")
+		return clcsql.QueryScalar(ctx, ec, query, params...)
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return vars.Set(name, value)
+}
+
+func listVariables(ctx context.Context, ec plug.ExecContext, vars *shell.Variables) error {
+	names := vars.Names()
+	if len(names) == 0 {
+		ec.PrintlnUnnecessary("OK No variables are set.")
+		return nil
+	}
+	rows := make([]output.Row, len(names))
+	for i, name := range names {
+		value, _ := vars.Get(name)
+		rows[i] = output.Row{
+			output.Column{Name: "Name", Type: serialization.TypeString, Value: name},
+			output.Column{Name: "Value", Type: serialization.TypeString, Value: value},
+		}
+	}
+	return ec.AddOutputRows(ctx, rows...)
+}
+
+// commandNameValue returns the name after the given command and the unquoted value after the name.
+func commandNameValue(command, text string) (name, value string) {
+	text = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(text), command))
+	fs := strings.Fields(text)
+	if len(fs) == 0 {
+		return "", ""
+	}
+	value = strings.TrimSpace(strings.TrimPrefix(text, fs[0]))
+	return fs[0], unquote(value)
+}
+
+// unquote removes the single or double quotes around the text, if there are any.
+func unquote(text string) string {
+	if len(text) < 2 {
+		return text
+	}
+	if q := text[0]; (q == '\'' || q == '"') && text[len(text)-1] == q {
+		return text[1 : len(text)-1]
+	}
+	return text
+}
+
+// scriptVariables returns the variables given with the --var flag.
+func scriptVariables(ec plug.ExecContext) (*shell.Variables, error) {
+	vars := shell.NewVariables()
+	vv, _ := ec.Props().Get(flagVar)
+	vs, _ := vv.([]string)
+	for _, v := range vs {
+		name, value, err := shell.ParseVariable(v)
+		if err != nil {
+			return nil, err
+		}
+		if err := vars.Set(name, value); err != nil {
+			return nil, err
+		}
+	}
+	return vars, nil
+}
@@ -2,7 +2,4 @@
 
 package snapshot
 
-const (
-	jetExportedSnapshotsMap   = "__jet.exportedSnapshotsCache"
-	jetExportedSnapshotPrefix = "__jet.exportedSnapshot."
-)
+const jetExportedSnapshotPrefix = "__jet.exportedSnapshot."
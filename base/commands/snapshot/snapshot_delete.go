@@ -9,6 +9,7 @@ import (
 	"github.com/hazelcast/hazelcast-commandline-client/clc"
 	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/jet"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
 )
 
@@ -24,6 +25,7 @@ func (DeleteCommand) Init(cc plug.InitContext) error {
 	help := "Delete a snapshot"
 	cc.SetCommandHelp(help, help)
 	cc.AddStringArg(argSnapshotName, argTitleSnapshotName)
+	cc.SetArgCompletion(jet.CompleteSnapshots)
 	return nil
 }
 
@@ -35,7 +37,7 @@ func (DeleteCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 			return nil, err
 		}
 		sp.SetText(fmt.Sprintf("Deleting the snapshot '%s'", name))
-		sm, err := ci.Client().GetMap(ctx, jetExportedSnapshotsMap)
+		sm, err := ci.Client().GetMap(ctx, jet.ExportedSnapshotsMap)
 		if err != nil {
 			return nil, err
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/hazelcast/hazelcast-commandline-client/clc"
 	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/jet"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
@@ -33,7 +34,7 @@ func (ListCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 			return nil, err
 		}
 		sp.SetText("Getting the snapshot list")
-		m, err := ci.Client().GetMap(ctx, jetExportedSnapshotsMap)
+		m, err := ci.Client().GetMap(ctx, jet.ExportedSnapshotsMap)
 		if err != nil {
 			return nil, err
 		}
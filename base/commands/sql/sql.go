@@ -5,6 +5,8 @@ package sql
 import (
 	"context"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/sql"
 
@@ -18,9 +20,17 @@ import (
 )
 
 const (
-	minServerVersion = "5.0.0"
-	argQuery         = "query"
-	argTitleQuery    = "query"
+	minServerVersion  = "5.0.0"
+	argQuery          = "query"
+	argTitleQuery     = "query"
+	flagParam         = "param"
+	flagExplain       = "explain"
+	flagFile          = "file"
+	flagStopOnError   = "stop-on-error"
+	flagContinue      = "continue"
+	flagWatch         = "watch"
+	flagWatchInterval = "watch-interval"
+	flagWatchRows     = "watch-rows"
 )
 
 type SQLCommand struct{}
@@ -45,22 +55,103 @@ func (SQLCommand) Init(cc plug.InitContext) error {
 	long := fmt.Sprintf(`Runs the given SQL query or starts the SQL shell
 
 If QUERY is not given, then the SQL shell is started.
+
+The ? placeholders in QUERY are bound to the --param values in order.
+
+If --explain is given, the execution plan of QUERY is displayed as a tree
+instead of running it. Use --format json to output the plan as JSON.
+
+If QUERY contains more than one statement separated by semicolons, or --file
+is given, the statements are executed in order. The output of each statement
+is displayed, followed by a summary of the executed statements.
+Execution stops at the first failing statement, unless --continue is given.
+
+If --watch is given, QUERY is executed every --watch-interval seconds and
+its rows are redrawn. If QUERY is a streaming query which runs longer than
+the interval, its last --watch-rows rows are redrawn every interval instead.
+Press Ctrl+C to stop watching.
+
+If --into-map is given, the rows of QUERY are put into the given Map instead
+of displaying them. The key of an entry is the value of the --key-column
+column, or the row number if it is not given. The value of an entry is the
+other columns as a JSON object, or as a Compact value if --value-format is
+compact:TYPE_NAME, where TYPE_NAME is a Compact type in the configuration.
+The name of the Map is used as the type name if --value-format is compact.
+Use --target-config to put the rows into a Map on another cluster.
+
+If --timeout is given, the query is cancelled on the cluster as well
+when it times out.
 	
 This command requires a Viridian or a Hazelcast cluster
 having version %s or better.
 `, minServerVersion)
 	cc.SetCommandHelp(long, "Run SQL")
 	cc.AddBoolFlag(clcsql.PropertyUseMappingSuggestion, "", false, false, "execute the proposed CREATE MAPPING suggestion and retry the query")
-	cc.AddStringArg(argQuery, argTitleQuery)
+	cc.AddStringSliceFlag(flagParam, "", false, "query parameter in the `TYPE:VALUE` format, e.g., i32:42; can be given more than once")
+	cc.AddBoolFlag(flagExplain, "", false, false, "display the execution plan of the query instead of running it")
+	cc.AddIntFlag(clcsql.PropertyCursorBufferSize, "", 0, false, "maximum number of rows the cluster buffers for the query, 4096 if not given")
+	cc.AddStringFlag(clcsql.PropertySchema, "", "", false, "schema to resolve the non-qualified object names in the query")
+	cc.AddStringFlag(flagFile, "", "", false, "run the SQL statements in the given file")
+	cc.AddBoolFlag(flagStopOnError, "", false, false, "stop at the first failing statement, this is the default")
+	cc.AddBoolFlag(flagContinue, "", false, false, "continue with the next statement if a statement fails")
+	cc.AddBoolFlag(flagWatch, "", false, false, "execute the query repeatedly and redraw its rows")
+	cc.AddIntFlag(flagWatchInterval, "", int64(clcsql.DefaultWatchInterval/time.Second), false, "seconds between the executions of the query with --watch")
+	cc.AddIntFlag(flagWatchRows, "", clcsql.DefaultWatchRows, false, "maximum number of rows displayed for a streaming query with --watch")
+	cc.AddStringFlag(flagIntoMap, "", "", false, "put the rows of the query into the given Map instead of displaying them")
+	cc.AddStringFlag(flagKeyColumn, "", "", false, "column to use as the key with --into-map, the row number is used if not given")
+	cc.AddStringFlag(flagValueFormat, "", valueFormatJSON, false, "format of the values with --into-map, one of: json, compact, compact:TYPE_NAME")
+	cc.AddStringFlag(flagTargetConfig, "", "", false, "configuration of the cluster of the --into-map Map, the current cluster is used if not given")
+	cc.AddIntFlag(flagBatchSize, "", defaultBatchSize, false, "number of entries put into the --into-map Map at once")
+	cc.AddStringSliceArg(argQuery, argTitleQuery, 0, 1)
 	return nil
 }
 
 func (SQLCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 	// this method is only for the non-interactive mode
-	if len(ec.Args()) < 1 {
+	file := ec.Props().GetString(flagFile)
+	args := ec.GetStringSliceArg(argQuery)
+	if len(args) < 1 && file == "" {
 		return nil
 	}
-	query := ec.GetStringArg(argQuery)
+	if ec.Props().GetBool(flagStopOnError) && ec.Props().GetBool(flagContinue) {
+		return fmt.Errorf("only one of --%s and --%s can be given", flagStopOnError, flagContinue)
+	}
+	pv, _ := ec.Props().Get(flagParam)
+	ps, _ := pv.([]string)
+	params, err := clcsql.ParseParams(ps)
+	if err != nil {
+		return err
+	}
+	explain := ec.Props().GetBool(flagExplain)
+	watch := ec.Props().GetBool(flagWatch)
+	into := ec.Props().GetString(flagIntoMap) != ""
+	if countTrue(explain, watch, into) > 1 {
+		return fmt.Errorf("only one of --%s, --%s and --%s can be given", flagExplain, flagWatch, flagIntoMap)
+	}
+	var query string
+	var stmts []string
+	if file != "" {
+		if len(args) > 0 {
+			return fmt.Errorf("either QUERY or --%s must be given, not both", flagFile)
+		}
+		if stmts, err = readStatements(file); err != nil {
+			return err
+		}
+	} else {
+		query = args[0]
+		// a single statement, with or without the trailing semicolon, is run as is
+		// the errors are reported by the cluster when the query is executed
+		stmts, _ = clcsql.SplitStatements(query)
+	}
+	if file != "" || len(stmts) > 1 {
+		if explain || watch || into || len(params) > 0 {
+			return fmt.Errorf("--%s, --%s, --%s and --%s cannot be used with --%s or multiple statements", flagExplain, flagWatch, flagIntoMap, flagParam, flagFile)
+		}
+		return execStatements(ctx, ec, stmts)
+	}
+	if into {
+		return intoMap(ctx, ec, query, params)
+	}
 	resV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
 		ci, err := cmd.ClientInternal(ctx, ec, sp)
 		if err != nil {
@@ -70,18 +161,113 @@ func (SQLCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 		if sv, ok := cmd.CheckServerCompatible(ci, minServerVersion); !ok {
 			return nil, fmt.Errorf("server (%s) does not support this command, at least %s is expected", sv, minServerVersion)
 		}
+		if watch {
+			return nil, nil
+		}
+		if explain {
+			sp.SetText("Retrieving the execution plan")
+			return clcsql.Explain(ctx, ec, query, params...)
+		}
 		sp.SetText("Executing SQL")
-		return clcsql.ExecSQL(ctx, ec, query)
+		return clcsql.ExecSQL(ctx, ec, query, params...)
 	})
 	if err != nil {
 		return err
 	}
+	if watch {
+		stop()
+		return watchQuery(ctx, ec, query, params)
+	}
 	// this should be deferred because UpdateOutput will iterate on the result
 	defer stop()
+	if explain {
+		return clcsql.PrintPlan(ec, resV.(*clcsql.PlanNode))
+	}
 	res := resV.(sql.Result)
 	return clcsql.UpdateOutput(ctx, ec, res)
 }
 
+// countTrue returns the number of true values.
+func countTrue(bs ...bool) int {
+	var n int
+	for _, b := range bs {
+		if b {
+			n++
+		}
+	}
+	return n
+}
+
+// watchQuery executes the given query repeatedly using the --watch options.
+func watchQuery(ctx context.Context, ec plug.ExecContext, query string, params []any) error {
+	interval := ec.Props().GetInt(flagWatchInterval)
+	if interval <= 0 {
+		return fmt.Errorf("%s must be positive", flagWatchInterval)
+	}
+	rows := ec.Props().GetInt(flagWatchRows)
+	if rows <= 0 {
+		return fmt.Errorf("%s must be positive", flagWatchRows)
+	}
+	opts := clcsql.WatchOptions{
+		Interval: time.Duration(interval) * time.Second,
+		MaxRows:  int(rows),
+	}
+	return clcsql.Watch(ctx, ec, query, opts, params...)
+}
+
+// readStatements returns the SQL statements in the given file.
+func readStatements(path string) ([]string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	stmts, err := clcsql.SplitStatements(string(b))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(stmts) == 0 {
+		return nil, fmt.Errorf("%s does not contain any statements", path)
+	}
+	return stmts, nil
+}
+
+// execStatements executes the given statements and displays a summary of them.
+func execStatements(ctx context.Context, ec plug.ExecContext, stmts []string) error {
+	_, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.sql")
+		if sv, ok := cmd.CheckServerCompatible(ci, minServerVersion); !ok {
+			return nil, fmt.Errorf("server (%s) does not support this command, at least %s is expected", sv, minServerVersion)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	cont := ec.Props().GetBool(flagContinue)
+	results := clcsql.ExecStatements(ctx, ec, stmts, !cont)
+	if err := ec.AddOutputRows(ctx, clcsql.StatementSummaryRows(results)...); err != nil {
+		return err
+	}
+	var failed int
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+	if !cont {
+		return fmt.Errorf("statement %d: %w", len(results), results[len(results)-1].Err)
+	}
+	return fmt.Errorf("%d of %d statements failed", failed, len(stmts))
+}
+
 func init() {
 	plug.Registry.RegisterAugmentor("20-sql", &SQLCommand{})
 	check.Must(plug.Registry.RegisterCommand("sql", &SQLCommand{}))
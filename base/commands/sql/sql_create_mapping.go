@@ -0,0 +1,158 @@
+//go:build std || sql
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	clcsql "github.com/hazelcast/hazelcast-commandline-client/clc/sql"
+	"github.com/hazelcast/hazelcast-commandline-client/errors"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/mapping"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/prompt"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+const (
+	argMapName        = "map"
+	argTitleMapName   = "map name"
+	flagSampleSize    = "sample-size"
+	flagDryRun        = "dry-run"
+	defaultSampleSize = 100
+)
+
+type CreateMappingCommand struct{}
+
+func (CreateMappingCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("create-mapping")
+	long := fmt.Sprintf(`Creates a SQL mapping for the given Map using its entries
+
+Up to --sample-size entries are sampled from the Map to infer the key and value formats:
+  * Compact values are mapped using their schemas.
+  * Portable values are mapped using their class definitions.
+  * JSON objects are mapped using the json-flat format, the field types are inferred across the samples.
+  * Other supported types are mapped to the corresponding SQL type.
+
+The proposed CREATE MAPPING statement is displayed and executed after confirmation.
+Use --dry-run to display the statement without executing it.
+
+This command requires a Viridian or a Hazelcast cluster
+having version %s or better.
+`, minServerVersion)
+	short := "Creates a SQL mapping for a Map using its entries"
+	cc.SetCommandHelp(long, short)
+	cc.AddIntFlag(flagSampleSize, "", defaultSampleSize, false, "maximum number of entries to sample")
+	cc.AddBoolFlag(flagDryRun, "", false, false, "display the CREATE MAPPING statement without executing it")
+	cc.AddBoolFlag(clc.FlagAutoYes, "", false, false, "skip confirming the creation of the mapping")
+	cc.AddStringArg(argMapName, argTitleMapName)
+	return nil
+}
+
+func (CreateMappingCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	mapName := ec.GetStringArg(argMapName)
+	size := int(ec.Props().GetInt(flagSampleSize))
+	if size <= 0 {
+		return fmt.Errorf("%s must be positive", flagSampleSize)
+	}
+	sidesV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.sql")
+		if sv, ok := cmd.CheckServerCompatible(ci, minServerVersion); !ok {
+			return nil, fmt.Errorf("server (%s) does not support this command, at least %s is expected", sv, minServerVersion)
+		}
+		sp.SetText(fmt.Sprintf("Sampling the entries of %s", mapName))
+		keys, values, err := sampleEntries(ctx, ec, ci, mapName, size)
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("map %s is empty, cannot infer the mapping", mapName)
+		}
+		key, err := inferMappingSide(keys, true, serialization.DecodedCompactSchema)
+		if err != nil {
+			return nil, err
+		}
+		value, err := inferMappingSide(values, false, serialization.DecodedCompactSchema)
+		if err != nil {
+			return nil, err
+		}
+		return []mappingSide{key, value}, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	sides := sidesV.([]mappingSide)
+	if skipped := append(sides[0].Skipped, sides[1].Skipped...); len(skipped) > 0 {
+		ec.PrintlnUnnecessary(fmt.Sprintf("Skipped the fields without a corresponding SQL type: %s", strings.Join(skipped, ", ")))
+	}
+	stmt := createMappingStatement(mapName, sides[0], sides[1])
+	check.I2(fmt.Fprintln(ec.Stdout(), stmt))
+	if ec.Props().GetBool(flagDryRun) {
+		return nil
+	}
+	if !ec.Props().GetBool(clc.FlagAutoYes) {
+		p := prompt.New(ec.Stdin(), ec.Stdout())
+		yes, err := p.YesNo("Create the mapping?")
+		if err != nil {
+			ec.Logger().Info("User input could not be processed due to error: %s", err.Error())
+			return errors.ErrUserCancelled
+		}
+		if !yes {
+			return errors.ErrUserCancelled
+		}
+	}
+	_, stop, err = ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		sp.SetText(fmt.Sprintf("Creating mapping %s", mapName))
+		res, err := clcsql.ExecSQL(ctx, ec, stmt)
+		if err != nil {
+			return nil, err
+		}
+		res.Close()
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	ec.PrintlnUnnecessary(fmt.Sprintf("OK Created mapping '%s'.", mapName))
+	return nil
+}
+
+// sampleEntries returns up to count keys and values from the given Map.
+func sampleEntries(ctx context.Context, ec plug.ExecContext, ci *hazelcast.ClientInternal, mapName string, count int) ([]dataSample, []dataSample, error) {
+	kds, vds, err := mapping.SampleEntries(ctx, ci, mapName, count)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]dataSample, len(kds))
+	values := make([]dataSample, len(vds))
+	for i := range kds {
+		keys[i] = decodeSample(ec, ci, kds[i])
+		values[i] = decodeSample(ec, ci, vds[i])
+	}
+	return keys, values, nil
+}
+
+func decodeSample(ec plug.ExecContext, ci *hazelcast.ClientInternal, d hazelcast.Data) dataSample {
+	v, err := ci.DecodeData(d)
+	if err != nil {
+		ec.Logger().Debugf("Could not decode the sampled data: %s", err.Error())
+		v = nil
+	}
+	return dataSample{Data: d, Value: v}
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("sql:create-mapping", &CreateMappingCommand{}))
+}
@@ -0,0 +1,229 @@
+//go:build std || sql
+
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	pubserialization "github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/config"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/paths"
+	clcsql "github.com/hazelcast/hazelcast-commandline-client/clc/sql"
+	"github.com/hazelcast/hazelcast-commandline-client/internal"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/log"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+const (
+	flagIntoMap        = "into-map"
+	flagKeyColumn      = "key-column"
+	flagValueFormat    = "value-format"
+	flagTargetConfig   = "target-config"
+	flagBatchSize      = "batch-size"
+	valueFormatJSON    = "json"
+	valueFormatCompact = "compact"
+	defaultBatchSize   = 1000
+)
+
+// intoMap executes the query and puts its rows into the --into-map Map.
+func intoMap(ctx context.Context, ec plug.ExecContext, query string, params []any) error {
+	mapName := ec.Props().GetString(flagIntoMap)
+	keyCol := ec.Props().GetString(flagKeyColumn)
+	compactType, err := compactTypeName(ec.Props().GetString(flagValueFormat), mapName)
+	if err != nil {
+		return err
+	}
+	size := int(ec.Props().GetInt(flagBatchSize))
+	if size <= 0 {
+		return fmt.Errorf("%s must be positive", flagBatchSize)
+	}
+	countV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		cmd.IncrementClusterMetric(ctx, ec, "total.sql")
+		if sv, ok := cmd.CheckServerCompatible(ci, minServerVersion); !ok {
+			return nil, fmt.Errorf("server (%s) does not support this command, at least %s is expected", sv, minServerVersion)
+		}
+		m, closeTarget, err := targetMap(ctx, ec, ci, mapName)
+		if err != nil {
+			return nil, err
+		}
+		defer closeTarget()
+		sp.SetText("Executing SQL")
+		res, err := clcsql.ExecSQL(ctx, ec, query, params...)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Close()
+		if !res.IsRowSet() {
+			return nil, errors.New("the query does not return rows")
+		}
+		it, err := res.Iterator()
+		if err != nil {
+			return nil, err
+		}
+		var count int64
+		batch := make([]types.Entry, 0, size)
+		flush := func() error {
+			if len(batch) == 0 {
+				return nil
+			}
+			if err := m.PutAll(ctx, batch...); err != nil {
+				return fmt.Errorf("putting the rows into %s: %w", mapName, err)
+			}
+			count += int64(len(batch))
+			batch = batch[:0]
+			sp.SetText(fmt.Sprintf("Copied %d rows to %s", count, mapName))
+			return nil
+		}
+		for it.HasNext() {
+			row, err := it.Next()
+			if err != nil {
+				return nil, err
+			}
+			orow, err := clcsql.OutputRow(row)
+			if err != nil {
+				return nil, err
+			}
+			e, err := rowEntry(orow, count+int64(len(batch))+1, keyCol, compactType)
+			if err != nil {
+				return nil, err
+			}
+			batch = append(batch, e)
+			if len(batch) == size {
+				if err := flush(); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err := flush(); err != nil {
+			return nil, err
+		}
+		return count, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	ec.PrintlnUnnecessary(fmt.Sprintf("OK Copied %d rows to map %s.", countV.(int64), mapName))
+	return nil
+}
+
+// compactTypeName returns the Compact type name in the given value format.
+// Returns an empty string for the JSON format, and mapName for the Compact format without a type name.
+func compactTypeName(format, mapName string) (string, error) {
+	switch format {
+	case valueFormatJSON:
+		return "", nil
+	case valueFormatCompact:
+		return mapName, nil
+	}
+	if tn := strings.TrimPrefix(format, internal.TypeNamePrefixCompact); tn != format && tn != "" {
+		return tn, nil
+	}
+	return "", fmt.Errorf("invalid value format: %s, expected %s, %s or %sTYPE_NAME", format, valueFormatJSON, valueFormatCompact, internal.TypeNamePrefixCompact)
+}
+
+// targetMap returns the Map to put the rows into.
+// If --target-config is given, the Map is on the cluster of that configuration,
+// and the returned function shuts down the client connected to it and restores the serialization registrations of the current configuration.
+func targetMap(ctx context.Context, ec plug.ExecContext, ci *hazelcast.ClientInternal, name string) (*hazelcast.Map, func(), error) {
+	tc := ec.Props().GetString(flagTargetConfig)
+	if tc == "" {
+		m, err := ci.Client().GetMap(ctx, name)
+		return m, func() {}, err
+	}
+	// creating the target configuration registers its Compact schemas and Portable classes,
+	// the ones of the current configuration are restored after the rows are put
+	saved := serialization.SaveRegistrations()
+	cfg, err := targetClientConfig(tc, ec.Logger())
+	if err != nil {
+		saved.Restore()
+		return nil, nil, fmt.Errorf("loading the target configuration: %w", err)
+	}
+	client, err := hazelcast.StartNewClientWithConfig(ctx, cfg)
+	if err != nil {
+		saved.Restore()
+		return nil, nil, fmt.Errorf("connecting to the target cluster: %w", err)
+	}
+	closeTarget := func() {
+		if err := client.Shutdown(context.Background()); err != nil {
+			ec.Logger().Error(err)
+		}
+		saved.Restore()
+	}
+	m, err := client.GetMap(ctx, name)
+	if err != nil {
+		closeTarget()
+		return nil, nil, err
+	}
+	return m, closeTarget, nil
+}
+
+// targetClientConfig returns the client configuration in the given configuration path or name.
+// Relative paths in the configuration, such as the SSL certificate paths, are resolved against the directory of the configuration.
+func targetClientConfig(path string, lg log.Logger) (hazelcast.Config, error) {
+	path, err := filepath.Abs(paths.ResolveConfigPath(path))
+	if err != nil {
+		return hazelcast.Config{}, err
+	}
+	fp, err := config.NewFileProvider(path)
+	if err != nil {
+		return hazelcast.Config{}, err
+	}
+	return config.MakeHzConfig(fp, lg)
+}
+
+// rowEntry returns the Map entry for the given row.
+// The key is the value of keyCol, or the row number if keyCol is empty.
+// The value is a JSON object of the other columns, converted to the given Compact type if compactType is not empty.
+func rowEntry(row output.Row, rowNum int64, keyCol, compactType string) (types.Entry, error) {
+	var key any = rowNum
+	found := keyCol == ""
+	obj := make(map[string]any, len(row))
+	for _, col := range row {
+		if keyCol != "" && col.Name == keyCol {
+			key = col.Value
+			found = true
+			continue
+		}
+		v, err := col.JSONValue()
+		if err != nil {
+			return types.Entry{}, fmt.Errorf("row %d: column %s: %w", rowNum, col.Name, err)
+		}
+		obj[col.Name] = v
+	}
+	if !found {
+		return types.Entry{}, fmt.Errorf("key column %s is not in the result", keyCol)
+	}
+	if check.IsNil(key) {
+		return types.Entry{}, fmt.Errorf("row %d: key column %s is null", rowNum, keyCol)
+	}
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return types.Entry{}, fmt.Errorf("row %d: %w", rowNum, err)
+	}
+	if compactType == "" {
+		return types.Entry{Key: key, Value: pubserialization.JSON(b)}, nil
+	}
+	v, err := serialization.NewCompactValue(compactType, string(b))
+	if err != nil {
+		return types.Entry{}, fmt.Errorf("row %d: %w", rowNum, err)
+	}
+	return types.Entry{Key: key, Value: v}, nil
+}
@@ -0,0 +1,67 @@
+//go:build std || sql
+
+package sql
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	pubserialization "github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-commandline-client/internal/log"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+func TestRowEntry(t *testing.T) {
+	row := output.Row{
+		output.Column{Name: "id", Type: serialization.TypeInt32, Value: int32(10)},
+		output.Column{Name: "name", Type: serialization.TypeString, Value: "foo"},
+		output.Column{Name: "score", Type: serialization.TypeNil, Value: nil},
+	}
+	e, err := rowEntry(row, 3, "id", "")
+	require.NoError(t, err)
+	require.Equal(t, types.Entry{Key: int32(10), Value: pubserialization.JSON(`{"name":"foo","score":null}`)}, e)
+	e, err = rowEntry(row, 3, "", "")
+	require.NoError(t, err)
+	require.Equal(t, types.Entry{Key: int64(3), Value: pubserialization.JSON(`{"id":10,"name":"foo","score":null}`)}, e)
+	_, err = rowEntry(row, 3, "missing", "")
+	require.EqualError(t, err, "key column missing is not in the result")
+	_, err = rowEntry(row, 3, "score", "")
+	require.EqualError(t, err, "row 3: key column score is null")
+}
+
+func TestCompactTypeName(t *testing.T) {
+	tn, err := compactTypeName("json", "report")
+	require.NoError(t, err)
+	require.Equal(t, "", tn)
+	tn, err = compactTypeName("compact:com.acme.Row", "report")
+	require.NoError(t, err)
+	require.Equal(t, "com.acme.Row", tn)
+	tn, err = compactTypeName("compact", "report")
+	require.NoError(t, err)
+	require.Equal(t, "report", tn)
+	_, err = compactTypeName("compact:", "report")
+	require.EqualError(t, err, "invalid value format: compact:, expected json, compact or compact:TYPE_NAME")
+}
+
+func TestTargetClientConfig_RelativeSSLPath(t *testing.T) {
+	dir := t.TempDir()
+	cfg := `cluster:
+  address: localhost:5701
+ssl:
+  enabled: true
+  ca-path: ca.pem
+`
+	path := filepath.Join(dir, "target.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(cfg), 0600))
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	rel, err := filepath.Rel(wd, path)
+	require.NoError(t, err)
+	_, err = targetClientConfig(rel, log.NopLogger{})
+	require.ErrorContains(t, err, filepath.Join(dir, "ca.pem"))
+}
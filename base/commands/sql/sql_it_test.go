@@ -5,6 +5,8 @@ package sql_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -15,6 +17,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands"
 	"github.com/hazelcast/hazelcast-commandline-client/clc"
@@ -34,6 +37,22 @@ func TestSQL(t *testing.T) {
 		{name: "SQL_Interactive", f: sql_InteractiveTest},
 		{name: "SQL_NonInteractive", f: sql_NonInteractiveTest},
 		{name: "SQL_NonInteractiveStreaming", f: sql_NonInteractiveStreamingTest},
+		{name: "SQL_Params_NonInteractive", f: sqlParams_NonInteractiveTest},
+		{name: "SQL_Bind_Interactive", f: sqlBind_InteractiveTest},
+		{name: "SQL_Explain_NonInteractive", f: sqlExplain_NonInteractiveTest},
+		{name: "SQL_Explain_Interactive", f: sqlExplain_InteractiveTest},
+		{name: "SQL_DescribeViews_Interactive", f: sqlDescribeViews_InteractiveTest},
+		{name: "SQL_File_NonInteractive", f: sqlFile_NonInteractiveTest},
+		{name: "SQL_File_Continue", f: sqlFile_ContinueTest},
+		{name: "SQL_MultipleStatements_Continue", f: sqlMultipleStatements_ContinueTest},
+		{name: "SQL_IntoMap_NonInteractive", f: sqlIntoMap_NonInteractiveTest},
+		{name: "SQL_IntoMapTargetConfig_NonInteractive", f: sqlIntoMapTargetConfig_NonInteractiveTest},
+		{name: "SQL_Timing_Interactive", f: sqlTiming_InteractiveTest},
+		{name: "SQL_Watch_NonInteractive", f: sqlWatch_NonInteractiveTest},
+		{name: "SQL_WatchStreaming_NonInteractive", f: sqlWatchStreaming_NonInteractiveTest},
+		{name: "SQL_Schema_NonInteractive", f: sqlSchema_NonInteractiveTest},
+		{name: "SQL_CreateMapping_NonInteractive", f: sqlCreateMapping_NonInteractiveTest},
+		{name: "SQL_Migrate_NonInteractive", f: sqlMigrate_NonInteractiveTest},
 		{name: "SQL_Suggestion_Interactive", f: sqlSuggestion_Interactive},
 		{name: "SQL_Suggestion_NonInteractive", f: sqlSuggestion_NonInteractive},
 	}
@@ -68,6 +87,279 @@ func sql_NonInteractiveTest(t *testing.T) {
 	})
 }
 
+func sqlParams_NonInteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		name := it.NewUniqueObjectName("table")
+		ctx := context.Background()
+		tcx.CLCExecute(ctx, "sql", intVarcharMapping(name))
+		tcx.CLCExecute(ctx, "sql", fmt.Sprintf(`INSERT INTO "%s" (__key, this) VALUES (?, ?), (?, ?);`, name),
+			"--param", "i32:10", "--param", "string:it's", "--param", "i32:20", "--param", "string:bar")
+		tcx.WithReset(func() {
+			tcx.CLCExecute(ctx, "sql", fmt.Sprintf(`SELECT * FROM "%s" WHERE __key = ?;`, name), "--param", "i32:10")
+			tcx.AssertStdoutEquals("10\tit's\n")
+		})
+	})
+}
+
+func sqlBind_InteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		tcx.WithShell(ctx, func(tcx it.TestContext) {
+			name := it.NewUniqueObjectName("table")
+			tcx.WriteStdinString(intVarcharMapping(name) + "\n")
+			tcx.WriteStdinString(`\bind i32:10 'string:foo bar'` + "\n")
+			tcx.WriteStdinf(`INSERT INTO "%s" (__key, this) VALUES (?, ?);`+"\n", name)
+			tcx.WithReset(func() {
+				tcx.WriteStdinf(`SELECT this FROM "%s";`+"\n", name)
+				tcx.AssertStdoutContains("foo bar")
+			})
+		})
+	})
+}
+
+func sqlExplain_NonInteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		name := it.NewUniqueObjectName("table")
+		ctx := context.Background()
+		tcx.CLCExecute(ctx, "sql", intVarcharMapping(name))
+		tcx.WithReset(func() {
+			tcx.CLCExecute(ctx, "sql", fmt.Sprintf(`SELECT this FROM "%s" WHERE __key > 10`, name), "--explain")
+			tcx.AssertStdoutContains("FullScanPhysicalRel")
+		})
+		tcx.WithReset(func() {
+			tcx.CLCExecute(ctx, "sql", fmt.Sprintf(`SELECT this FROM "%s"`, name), "--explain", "--format", "json")
+			tcx.AssertStdoutContains(`"operator":"FullScanPhysicalRel"`)
+		})
+	})
+}
+
+func sqlExplain_InteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		tcx.WithShell(ctx, func(tcx it.TestContext) {
+			name := it.NewUniqueObjectName("table")
+			tcx.WriteStdinString(intVarcharMapping(name) + "\n")
+			tcx.WithReset(func() {
+				tcx.WriteStdinf(`\explain SELECT this FROM "%s";`+"\n", name)
+				tcx.AssertStdoutContains("FullScanPhysicalRel")
+			})
+		})
+	})
+}
+
+func sqlDescribeViews_InteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		tcx.WithShell(ctx, func(tcx it.TestContext) {
+			name := it.NewUniqueObjectName("table")
+			tcx.WriteStdinString(intVarcharMapping(name) + "\n")
+			tcx.WriteStdinf(`CREATE VIEW "%[1]s_view" AS SELECT this FROM "%[1]s";`+"\n", name)
+			tcx.WithReset(func() {
+				tcx.WriteStdinf(`\dv %s_*`+"\n", name)
+				tcx.AssertStdoutContains(name + "_view")
+			})
+			tcx.WithReset(func() {
+				tcx.WriteStdinf(`\dv+ %s_view`+"\n", name)
+				tcx.AssertStdoutContains("CREATE OR REPLACE VIEW")
+			})
+		})
+	})
+}
+
+func sqlFile_NonInteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		name := it.NewUniqueObjectName("table")
+		path := filepath.Join(t.TempDir(), "001.sql")
+		text := fmt.Sprintf(`
+			-- create the mapping; then insert
+			%[2]s
+			INSERT INTO "%[1]s" VALUES (1, 'foo;bar'), (2, 'it''s');
+			/* check; the values */
+			SELECT this FROM "%[1]s" ORDER BY __key;
+		`, name, intVarcharMapping(name))
+		check.Must(os.WriteFile(path, []byte(text), 0600))
+		tcx.WithReset(func() {
+			tcx.CLCExecute(ctx, "sql", "--file", path)
+			tcx.AssertStdoutContains("foo;bar\nit's\n")
+			tcx.AssertStdoutContains("3\tSELECT this FROM")
+		})
+	})
+}
+
+func sqlFile_ContinueTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		path := filepath.Join(t.TempDir(), "continue.sql")
+		check.Must(os.WriteFile(path, []byte("SELECT * FROM not_existing; SELECT 'foo';"), 0600))
+		err := tcx.CLC().Execute(ctx, "sql", "--file", path, "--continue")
+		require.Error(t, err)
+		require.Equal(t, "1 of 2 statements failed", err.Error())
+		tcx.AssertStdoutContains("foo")
+		tcx.AssertStderrContains("Statement 1:")
+	})
+}
+
+func sqlMultipleStatements_ContinueTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		err := tcx.CLC().Execute(ctx, "sql", "SELECT * FROM not_existing; SELECT 'foo';", "--continue")
+		require.Error(t, err)
+		require.Equal(t, "1 of 2 statements failed", err.Error())
+		tcx.AssertStdoutContains("foo")
+	})
+}
+
+func sqlMigrate_NonInteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		name := it.NewUniqueObjectName("table")
+		historyMap := it.NewUniqueObjectName("history")
+		dir := t.TempDir()
+		v1 := intVarcharMapping(name)
+		check.Must(os.WriteFile(filepath.Join(dir, "001_create_mapping.sql"), []byte(v1), 0600))
+		v2 := fmt.Sprintf(`INSERT INTO "%s" VALUES (1, 'foo');`, name)
+		check.Must(os.WriteFile(filepath.Join(dir, "002_insert.sql"), []byte(v2), 0600))
+		tcx.WithReset(func() {
+			tcx.CLCExecute(ctx, "sql", "migrate", "--dir", dir, "--history-map", historyMap)
+			tcx.AssertStdoutContains("OK Applied migration 2 (002_insert.sql).")
+		})
+		tcx.WithReset(func() {
+			tcx.CLCExecute(ctx, "sql", "migrate", "--dir", dir, "--history-map", historyMap)
+			tcx.AssertStdoutContains("OK There are no pending migrations.")
+		})
+		check.Must(os.WriteFile(filepath.Join(dir, "002_insert.sql"), []byte(v2+"\n-- edited"), 0600))
+		tcx.WithReset(func() {
+			tcx.CLCExecute(ctx, "sql", "migrate", "status", "--dir", dir, "--history-map", historyMap)
+			tcx.AssertStdoutContains("1\tcreate mapping\t001_create_mapping.sql\tapplied")
+			tcx.AssertStdoutContains("2\tinsert\t002_insert.sql\tchanged")
+		})
+		err := tcx.CLC().Execute(ctx, "sql", "migrate", "validate", "--dir", dir, "--history-map", historyMap)
+		require.Error(t, err)
+		require.Equal(t, "validation failed: migration 2 (002_insert.sql) was changed after it was applied", err.Error())
+	})
+}
+
+func sqlWatch_NonInteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		// the query is watched until the timeout
+		tcx.CLCExecute(ctx, "sql", "--watch", "--watch-interval", "1", "--timeout", "3s", "SELECT 'foo' AS v")
+		tcx.AssertStdoutContains("Every 1s: SELECT 'foo' AS v")
+		tcx.AssertStdoutContains("foo")
+	})
+}
+
+func sqlWatchStreaming_NonInteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		tcx.CLCExecute(ctx, "sql", "--watch", "--watch-interval", "1", "--watch-rows", "5", "--timeout", "3s", "SELECT v FROM TABLE(generate_stream(10))")
+		tcx.AssertStdoutContains("streaming, last 5 rows")
+	})
+}
+
+func sqlIntoMap_NonInteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		name := it.NewUniqueObjectName("table")
+		target := it.NewUniqueObjectName("map")
+		tcx.CLCExecute(ctx, "sql", intVarcharMapping(name))
+		tcx.CLCExecute(ctx, "sql", fmt.Sprintf(`INSERT INTO "%s" VALUES (1, 'foo'), (2, 'bar');`, name))
+		tcx.WithReset(func() {
+			tcx.CLCExecute(ctx, "sql", "--into-map", target, "--key-column", "id", "--batch-size", "1", fmt.Sprintf(`SELECT __key AS id, this AS name FROM "%s"`, name))
+			tcx.AssertStdoutContains(fmt.Sprintf("OK Copied 2 rows to map %s.", target))
+		})
+		m := check.MustValue(tcx.Client.GetMap(ctx, target))
+		v := check.MustValue(m.Get(ctx, int32(2)))
+		require.Equal(t, serialization.JSON(`{"name":"bar"}`), v)
+	})
+}
+
+func sqlIntoMapTargetConfig_NonInteractiveTest(t *testing.T) {
+	dir := t.TempDir()
+	mainSchemas := writeCompactSchema(t, dir, "main-schemas.yaml", "com.acme.Main")
+	targetSchemas := writeCompactSchema(t, dir, "target-schemas.yaml", "com.acme.Target")
+	tcx := it.TestContext{
+		T: t,
+		ExtraConfig: map[string]any{
+			"serialization": map[string]any{"compact-schemas": mainSchemas},
+		},
+	}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		cfg := it.ConfigToMap(*tcx.ClientConfig)
+		cfg["serialization"] = map[string]any{"compact-schemas": targetSchemas}
+		targetConfig := filepath.Join(dir, "target.yaml")
+		check.Must(os.WriteFile(targetConfig, check.MustValue(yaml.Marshal(cfg)), 0600))
+		name := it.NewUniqueObjectName("table")
+		target := it.NewUniqueObjectName("map")
+		tcx.CLCExecute(ctx, "sql", intVarcharMapping(name))
+		tcx.CLCExecute(ctx, "sql", fmt.Sprintf(`INSERT INTO "%s" VALUES (1, 'foo');`, name))
+		tcx.WithReset(func() {
+			tcx.CLCExecute(ctx, "sql", "--into-map", target, "--target-config", targetConfig, "--value-format", "compact:com.acme.Target", fmt.Sprintf(`SELECT this AS name FROM "%s"`, name))
+			tcx.AssertStdoutContains(fmt.Sprintf("OK Copied 1 rows to map %s.", target))
+		})
+		// the Compact types of the main configuration must still be usable after the rows are put into the target cluster
+		m := it.NewUniqueObjectName("map")
+		tcx.WithReset(func() {
+			tcx.CLCExecute(ctx, "map", "-n", m, "set", "k1", `{"name": "bar"}`, "--value-type", "compact:com.acme.Main", "-q")
+			tcx.CLCExecute(ctx, "map", "-n", m, "get", "k1", "-q")
+			tcx.AssertStdoutContains("bar")
+		})
+	})
+}
+
+func writeCompactSchema(t *testing.T, dir, file, typeName string) string {
+	b := fmt.Sprintf("types:\n  - type-name: %s\n    fields:\n      - name: name\n        kind: STRING\n", typeName)
+	path := filepath.Join(dir, file)
+	require.NoError(t, os.WriteFile(path, []byte(b), 0600))
+	return path
+}
+
+func sqlTiming_InteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		tcx.WithShell(ctx, func(tcx it.TestContext) {
+			name := it.NewUniqueObjectName("table")
+			tcx.WriteStdinString(intVarcharMapping(name) + "\n")
+			tcx.WriteStdinf(`INSERT INTO "%s" VALUES (1, 'foo'), (2, 'bar');`+"\n", name)
+			tcx.WithReset(func() {
+				tcx.WriteStdinString(`\timing` + "\n")
+				tcx.AssertStdoutContains("OK Timing is on.")
+			})
+			tcx.WithReset(func() {
+				tcx.WriteStdinf(`SELECT * FROM "%s";`+"\n", name)
+				tcx.AssertStdoutContains("time to first row")
+				tcx.AssertStdoutContains("rows: 2")
+			})
+		})
+	})
+}
+
+func sqlSchema_NonInteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		tcx.WithReset(func() {
+			tcx.CLCExecute(ctx, "sql", "SELECT table_name FROM mappings LIMIT 1", "--schema", "information_schema", "--cursor-buffer-size", "10")
+			tcx.AssertStdoutContains("OK Returned")
+		})
+	})
+}
+
 func sql_NonInteractiveStreamingTest(t *testing.T) {
 	it.MarkFlaky(t, "https://github.com/hazelcast/hazelcast-commandline-client/issues/357")
 	tcx := it.TestContext{T: t}
@@ -190,6 +482,11 @@ $-------------------------------------------------------------------------------
 	})
 }
 
+// intVarcharMapping returns the statement which creates the mapping for an IMap with INT keys and VARCHAR values.
+func intVarcharMapping(name string) string {
+	return fmt.Sprintf(`CREATE MAPPING "%s" (__key INT, this VARCHAR) TYPE IMAP OPTIONS ('keyFormat' = 'int', 'valueFormat' = 'varchar');`, name)
+}
+
 func addIndex(m *hz.Map) error {
 	err := m.Set(context.Background(), "k1", serialization.JSON(`{"A": 10, "B": 40}`))
 	if err != nil {
@@ -207,6 +504,29 @@ func addIndex(m *hz.Map) error {
 	return nil
 }
 
+func sqlCreateMapping_NonInteractiveTest(t *testing.T) {
+	tcx := it.TestContext{T: t}
+	tcx.Tester(func(tcx it.TestContext) {
+		ctx := context.Background()
+		it.WithMap(tcx, func(m *hazelcast.Map) {
+			check.Must(m.Set(ctx, int32(10), serialization.JSON(`{"name": "foo", "age": 20}`)))
+			check.Must(m.Set(ctx, int32(20), serialization.JSON(`{"name": "bar", "score": 1.5}`)))
+			tcx.WithReset(func() {
+				tcx.CLCExecute(ctx, "sql", "create-mapping", m.Name(), "--dry-run")
+				tcx.AssertStdoutContains(`"__key" INTEGER`)
+				tcx.AssertStdoutContains(`"age" BIGINT`)
+				tcx.AssertStdoutContains(`"score" DOUBLE`)
+				tcx.AssertStdoutContains(`'valueFormat' = 'json-flat'`)
+			})
+			tcx.CLCExecute(ctx, "sql", "create-mapping", m.Name(), "--yes")
+			tcx.WithReset(func() {
+				tcx.CLCExecute(ctx, "sql", fmt.Sprintf(`SELECT __key, name FROM "%s" ORDER BY __key;`, m.Name()))
+				tcx.AssertStdoutContains("10\tfoo\n20\tbar\n")
+			})
+		})
+	})
+}
+
 func sqlSuggestion_Interactive(t *testing.T) {
 	tcx := it.TestContext{T: t}
 	tcx.Tester(func(tcx it.TestContext) {
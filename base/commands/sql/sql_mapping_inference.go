@@ -0,0 +1,389 @@
+//go:build std || sql
+
+package sql
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	pubserialization "github.com/hazelcast/hazelcast-go-client/serialization"
+
+	"github.com/hazelcast/hazelcast-commandline-client/internal/mapping"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+const (
+	sqlColumnKey   = "__key"
+	sqlColumnValue = "this"
+	sqlTypeObject  = "OBJECT"
+	sqlTypeVarchar = "VARCHAR"
+	sqlTypeBigint  = "BIGINT"
+	sqlTypeDouble  = "DOUBLE"
+	sqlTypeBoolean = "BOOLEAN"
+)
+
+// schemaLookupFn returns the Compact schema with the given ID.
+type schemaLookupFn func(id int64) (serialization.CompactSchema, bool)
+
+// dataSample is a key or a value sampled from a Map, with its decoded value.
+// Value is nil if the data could not be decoded.
+type dataSample struct {
+	Data  hazelcast.Data
+	Value any
+}
+
+type mappingColumn struct {
+	Name         string
+	Type         string
+	ExternalName string
+}
+
+type mappingOption struct {
+	Name  string
+	Value string
+}
+
+// mappingSide is the inferred format and the columns for the key or the value of a mapping.
+type mappingSide struct {
+	Options []mappingOption
+	Columns []mappingColumn
+	// Skipped contains the fields which don't have a corresponding SQL type.
+	Skipped []string
+}
+
+type sqlScalarType struct {
+	sqlType string
+	format  string
+}
+
+// sqlScalarTypes maps the serialization types to the SQL types and the key or value formats.
+var sqlScalarTypes = map[int32]sqlScalarType{
+	serialization.TypeBool:               {sqlType: sqlTypeBoolean, format: "boolean"},
+	serialization.TypeByte:               {sqlType: "TINYINT", format: "tinyint"},
+	serialization.TypeInt16:              {sqlType: "SMALLINT", format: "smallint"},
+	serialization.TypeInt32:              {sqlType: "INTEGER", format: "int"},
+	serialization.TypeInt64:              {sqlType: sqlTypeBigint, format: "bigint"},
+	serialization.TypeFloat32:            {sqlType: "REAL", format: "real"},
+	serialization.TypeFloat64:            {sqlType: sqlTypeDouble, format: "double"},
+	serialization.TypeString:             {sqlType: sqlTypeVarchar, format: "varchar"},
+	serialization.TypeJavaDecimal:        {sqlType: "DECIMAL", format: "decimal"},
+	serialization.TypeJavaLocalDate:      {sqlType: "DATE", format: "date"},
+	serialization.TypeJavaLocalTime:      {sqlType: "TIME", format: "time"},
+	serialization.TypeJavaLocalDateTime:  {sqlType: "TIMESTAMP", format: "timestamp"},
+	serialization.TypeJavaOffsetDateTime: {sqlType: "TIMESTAMP WITH TIME ZONE", format: "timestamp with time zone"},
+}
+
+// compactSQLTypes maps the Compact field kinds to the SQL types.
+var compactSQLTypes = map[pubserialization.FieldKind]string{
+	pubserialization.FieldKindBoolean:               sqlTypeBoolean,
+	pubserialization.FieldKindNullableBoolean:       sqlTypeBoolean,
+	pubserialization.FieldKindInt8:                  "TINYINT",
+	pubserialization.FieldKindNullableInt8:          "TINYINT",
+	pubserialization.FieldKindInt16:                 "SMALLINT",
+	pubserialization.FieldKindNullableInt16:         "SMALLINT",
+	pubserialization.FieldKindInt32:                 "INTEGER",
+	pubserialization.FieldKindNullableInt32:         "INTEGER",
+	pubserialization.FieldKindInt64:                 sqlTypeBigint,
+	pubserialization.FieldKindNullableInt64:         sqlTypeBigint,
+	pubserialization.FieldKindFloat32:               "REAL",
+	pubserialization.FieldKindNullableFloat32:       "REAL",
+	pubserialization.FieldKindFloat64:               sqlTypeDouble,
+	pubserialization.FieldKindNullableFloat64:       sqlTypeDouble,
+	pubserialization.FieldKindString:                sqlTypeVarchar,
+	pubserialization.FieldKindDecimal:               "DECIMAL",
+	pubserialization.FieldKindDate:                  "DATE",
+	pubserialization.FieldKindTime:                  "TIME",
+	pubserialization.FieldKindTimestamp:             "TIMESTAMP",
+	pubserialization.FieldKindTimestampWithTimezone: "TIMESTAMP WITH TIME ZONE",
+}
+
+// inferMappingSide infers the format and the columns for the key or the value of a mapping from the given samples.
+// All samples must have the same serialization type.
+func inferMappingSide(samples []dataSample, key bool, lookup schemaLookupFn) (mappingSide, error) {
+	what := mapping.OptionValuePrefix
+	if key {
+		what = mapping.OptionKeyPrefix
+	}
+	if len(samples) == 0 {
+		return mappingSide{}, fmt.Errorf("no %s samples", what)
+	}
+	t := samples[0].Data.Type()
+	for _, s := range samples[1:] {
+		if s.Data.Type() != t {
+			return mappingSide{}, fmt.Errorf("%s serialization types differ: %s and %s", what, serialization.TypeToLabel(t), serialization.TypeToLabel(s.Data.Type()))
+		}
+	}
+	var side mappingSide
+	var err error
+	switch t {
+	case serialization.TypeJSONSerialization:
+		side, err = inferJSON(samples, key)
+	case serialization.TypeCompact:
+		side, err = inferCompact(samples, lookup)
+	case serialization.TypePortable:
+		side, err = inferPortable(samples)
+	default:
+		st, ok := sqlScalarTypes[t]
+		if !ok {
+			return mappingSide{}, fmt.Errorf("%s serialization type is not supported: %s", what, serialization.TypeToLabel(t))
+		}
+		side = mappingSide{
+			Options: []mappingOption{{Name: mapping.OptionFormat, Value: st.format}},
+			Columns: []mappingColumn{{Name: topColumnName(key), Type: st.sqlType}},
+		}
+	}
+	if err != nil {
+		return mappingSide{}, fmt.Errorf("inferring the %s format: %w", what, err)
+	}
+	for i, opt := range side.Options {
+		side.Options[i].Name = what + opt.Name
+	}
+	if key {
+		for i, col := range side.Columns {
+			if col.Name != sqlColumnKey {
+				side.Columns[i].ExternalName = sqlColumnKey + "." + col.Name
+			}
+		}
+	}
+	return side, nil
+}
+
+func topColumnName(key bool) string {
+	if key {
+		return sqlColumnKey
+	}
+	return sqlColumnValue
+}
+
+// inferJSON infers the field types of the JSON objects.
+// The json-flat format is used if all samples are objects, otherwise the json format is used.
+func inferJSON(samples []dataSample, key bool) (mappingSide, error) {
+	fields := map[string]string{}
+	for _, s := range samples {
+		v, ok := s.Value.(pubserialization.JSON)
+		if !ok {
+			return mappingSide{}, fmt.Errorf("JSON value could not be decoded")
+		}
+		dec := json.NewDecoder(bytes.NewReader(v))
+		dec.UseNumber()
+		var obj map[string]any
+		if err := dec.Decode(&obj); err != nil || obj == nil {
+			// not an object
+			return mappingSide{
+				Options: []mappingOption{{Name: mapping.OptionFormat, Value: mapping.FormatJSON}},
+				Columns: []mappingColumn{{Name: topColumnName(key), Type: "JSON"}},
+			}, nil
+		}
+		for name, fv := range obj {
+			fields[name] = mergeSQLTypes(fields[name], jsonSQLType(fv))
+		}
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	side := mappingSide{
+		Options: []mappingOption{{Name: mapping.OptionFormat, Value: mapping.FormatJSONFlat}},
+	}
+	for _, name := range names {
+		t := fields[name]
+		if t == "" {
+			// all values are null
+			t = sqlTypeVarchar
+		}
+		side.Columns = append(side.Columns, mappingColumn{Name: name, Type: t})
+	}
+	return side, nil
+}
+
+// jsonSQLType returns the SQL type for the given JSON value.
+// Returns an empty string for null.
+func jsonSQLType(v any) string {
+	switch vv := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return sqlTypeVarchar
+	case bool:
+		return sqlTypeBoolean
+	case json.Number:
+		if _, err := strconv.ParseInt(vv.String(), 10, 64); err == nil {
+			return sqlTypeBigint
+		}
+		return sqlTypeDouble
+	default:
+		return sqlTypeObject
+	}
+}
+
+// mergeSQLTypes returns the SQL type which can hold the values of both types.
+func mergeSQLTypes(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "", a == b:
+		return a
+	case (a == sqlTypeBigint && b == sqlTypeDouble) || (a == sqlTypeDouble && b == sqlTypeBigint):
+		return sqlTypeDouble
+	}
+	return sqlTypeObject
+}
+
+// inferCompact infers the columns using the schemas of the Compact values.
+func inferCompact(samples []dataSample, lookup schemaLookupFn) (mappingSide, error) {
+	var typeName string
+	var side mappingSide
+	seen := map[string]struct{}{}
+	for _, s := range samples {
+		if len(s.Data) < serialization.DataHeaderSize+8 {
+			return mappingSide{}, fmt.Errorf("invalid Compact data")
+		}
+		id := int64(binary.BigEndian.Uint64(s.Data[serialization.DataHeaderSize:]))
+		schema, ok := lookup(id)
+		if !ok {
+			return mappingSide{}, fmt.Errorf("Compact schema %d is not known", id)
+		}
+		if typeName != "" && schema.TypeName != typeName {
+			return mappingSide{}, fmt.Errorf("Compact type names differ: %s and %s", typeName, schema.TypeName)
+		}
+		typeName = schema.TypeName
+		for _, f := range schema.Fields {
+			if _, ok := seen[f.Name]; ok {
+				continue
+			}
+			seen[f.Name] = struct{}{}
+			kind, _ := serialization.CompactFieldKind(f.Kind)
+			t, ok := compactSQLTypes[kind]
+			if !ok {
+				side.Skipped = append(side.Skipped, f.Name)
+				continue
+			}
+			side.Columns = append(side.Columns, mappingColumn{Name: f.Name, Type: t})
+		}
+	}
+	side.Options = []mappingOption{
+		{Name: mapping.OptionFormat, Value: mapping.FormatCompact},
+		{Name: mapping.OptionCompactTypeName, Value: typeName},
+	}
+	return side, nil
+}
+
+// inferPortable infers the columns using the fields of the Portable values.
+// The latest class version in the samples is used.
+func inferPortable(samples []dataSample) (mappingSide, error) {
+	var fid, cid, version int32
+	var side mappingSide
+	seen := map[string]struct{}{}
+	for i, s := range samples {
+		if len(s.Data) < serialization.DataHeaderSize+12 {
+			return mappingSide{}, fmt.Errorf("invalid Portable data")
+		}
+		f := int32(binary.BigEndian.Uint32(s.Data[serialization.DataHeaderSize:]))
+		c := int32(binary.BigEndian.Uint32(s.Data[serialization.DataHeaderSize+4:]))
+		v := int32(binary.BigEndian.Uint32(s.Data[serialization.DataHeaderSize+8:]))
+		if i > 0 && (f != fid || c != cid) {
+			return mappingSide{}, fmt.Errorf("Portable classes differ: %d:%d and %d:%d", fid, cid, f, c)
+		}
+		fid, cid = f, c
+		if v > version {
+			version = v
+		}
+		p, ok := s.Value.(*serialization.GenericPortable)
+		if !ok {
+			return mappingSide{}, fmt.Errorf("Portable value could not be decoded")
+		}
+		for _, col := range p.Fields {
+			if _, ok := seen[col.Name]; ok {
+				continue
+			}
+			seen[col.Name] = struct{}{}
+			t, ok := portableSQLType(col.Type)
+			if !ok {
+				side.Skipped = append(side.Skipped, col.Name)
+				continue
+			}
+			side.Columns = append(side.Columns, mappingColumn{Name: col.Name, Type: t})
+		}
+	}
+	side.Options = []mappingOption{
+		{Name: mapping.OptionFormat, Value: mapping.FormatPortable},
+		{Name: mapping.OptionPortableFactoryID, Value: strconv.Itoa(int(fid))},
+		{Name: mapping.OptionPortableClassID, Value: strconv.Itoa(int(cid))},
+	}
+	if version != 0 {
+		side.Options = append(side.Options, mappingOption{Name: mapping.OptionPortableClassVersion, Value: strconv.Itoa(int(version))})
+	}
+	return side, nil
+}
+
+func portableSQLType(t int32) (string, bool) {
+	if t == serialization.TypeUInt16 {
+		// char fields are mapped to VARCHAR
+		return sqlTypeVarchar, true
+	}
+	st, ok := sqlScalarTypes[t]
+	return st.sqlType, ok
+}
+
+// createMappingStatement returns the CREATE MAPPING statement for the given key and value columns and options.
+// The key fields which have the same name as a value field are renamed.
+func createMappingStatement(mapName string, key, value mappingSide) string {
+	names := map[string]struct{}{}
+	for _, col := range value.Columns {
+		names[col.Name] = struct{}{}
+	}
+	cols := make([]mappingColumn, 0, len(key.Columns)+len(value.Columns))
+	for _, col := range key.Columns {
+		if _, ok := names[col.Name]; ok && col.ExternalName != "" {
+			col.Name = "key_" + col.Name
+		}
+		cols = append(cols, col)
+	}
+	cols = append(cols, value.Columns...)
+	var sb strings.Builder
+	sb.WriteString("CREATE MAPPING ")
+	sb.WriteString(quoteIdentifier(mapName))
+	sb.WriteString(" (\n")
+	for i, col := range cols {
+		sb.WriteString("  ")
+		sb.WriteString(quoteIdentifier(col.Name))
+		sb.WriteByte(' ')
+		sb.WriteString(col.Type)
+		if col.ExternalName != "" {
+			sb.WriteString(" EXTERNAL NAME ")
+			sb.WriteString(quoteIdentifier(col.ExternalName))
+		}
+		if i < len(cols)-1 {
+			sb.WriteByte(',')
+		}
+		sb.WriteByte('\n')
+	}
+	sb.WriteString(")\nTYPE ")
+	sb.WriteString(mapping.TypeIMap)
+	sb.WriteString("\nOPTIONS (\n")
+	opts := append(append([]mappingOption{}, key.Options...), value.Options...)
+	for i, opt := range opts {
+		sb.WriteString(fmt.Sprintf("  %s = %s", quoteString(opt.Name), quoteString(opt.Value)))
+		if i < len(opts)-1 {
+			sb.WriteByte(',')
+		}
+		sb.WriteByte('\n')
+	}
+	sb.WriteString(");")
+	return sb.String()
+}
+
+func quoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,154 @@
+//go:build std || sql
+
+package sql
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	pubserialization "github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+func TestInferMappingSide_Scalar(t *testing.T) {
+	side, err := inferMappingSide([]dataSample{
+		{Data: testData(serialization.TypeInt32)},
+		{Data: testData(serialization.TypeInt32)},
+	}, true, nil)
+	require.NoError(t, err)
+	require.Equal(t, []mappingOption{{Name: "keyFormat", Value: "int"}}, side.Options)
+	require.Equal(t, []mappingColumn{{Name: "__key", Type: "INTEGER"}}, side.Columns)
+}
+
+func TestInferMappingSide_DifferentTypes(t *testing.T) {
+	_, err := inferMappingSide([]dataSample{
+		{Data: testData(serialization.TypeInt32)},
+		{Data: testData(serialization.TypeString)},
+	}, false, nil)
+	require.EqualError(t, err, "value serialization types differ: INT32 and STRING")
+}
+
+func TestInferMappingSide_JSON(t *testing.T) {
+	side, err := inferMappingSide([]dataSample{
+		testJSONSample(`{"name": "foo", "age": 10, "score": 1, "tags": ["a"], "extra": null}`),
+		testJSONSample(`{"name": "bar", "age": 20, "score": 2.5, "active": true, "extra": null}`),
+		testJSONSample(`{"name": 5}`),
+	}, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, []mappingOption{{Name: "valueFormat", Value: "json-flat"}}, side.Options)
+	require.Equal(t, []mappingColumn{
+		{Name: "active", Type: "BOOLEAN"},
+		{Name: "age", Type: "BIGINT"},
+		{Name: "extra", Type: "VARCHAR"},
+		{Name: "name", Type: "OBJECT"},
+		{Name: "score", Type: "DOUBLE"},
+		{Name: "tags", Type: "OBJECT"},
+	}, side.Columns)
+}
+
+func TestInferMappingSide_JSONNotObject(t *testing.T) {
+	side, err := inferMappingSide([]dataSample{
+		testJSONSample(`{"name": "foo"}`),
+		testJSONSample(`[1, 2]`),
+	}, true, nil)
+	require.NoError(t, err)
+	require.Equal(t, []mappingOption{{Name: "keyFormat", Value: "json"}}, side.Options)
+	require.Equal(t, []mappingColumn{{Name: "__key", Type: "JSON"}}, side.Columns)
+}
+
+func TestInferMappingSide_Compact(t *testing.T) {
+	schema := serialization.CompactSchema{
+		TypeName: "person",
+		Fields: []serialization.CompactFieldSchema{
+			{Name: "age", Kind: "INT32"},
+			{Name: "name", Kind: "STRING"},
+			{Name: "tags", Kind: "ARRAY_OF_STRING"},
+		},
+	}
+	lookup := func(id int64) (serialization.CompactSchema, bool) {
+		return schema, id == 42
+	}
+	d := testData(serialization.TypeCompact, 0, 0, 0, 0, 0, 0, 0, 42)
+	side, err := inferMappingSide([]dataSample{{Data: d}}, true, lookup)
+	require.NoError(t, err)
+	require.Equal(t, []mappingOption{
+		{Name: "keyFormat", Value: "compact"},
+		{Name: "keyCompactTypeName", Value: "person"},
+	}, side.Options)
+	require.Equal(t, []mappingColumn{
+		{Name: "age", Type: "INTEGER", ExternalName: "__key.age"},
+		{Name: "name", Type: "VARCHAR", ExternalName: "__key.name"},
+	}, side.Columns)
+	require.Equal(t, []string{"tags"}, side.Skipped)
+	_, err = inferMappingSide([]dataSample{{Data: testData(serialization.TypeCompact, 0, 0, 0, 0, 0, 0, 0, 1)}}, false, lookup)
+	require.EqualError(t, err, "inferring the value format: Compact schema 1 is not known")
+}
+
+func TestInferMappingSide_Portable(t *testing.T) {
+	p := &serialization.GenericPortable{
+		FID: 1,
+		CID: 2,
+		Fields: serialization.ColumnMap{
+			{Name: "id", Type: serialization.TypeInt64},
+			{Name: "initial", Type: serialization.TypeUInt16},
+			{Name: "scores", Type: serialization.TypeInt32Array},
+		},
+	}
+	d := testData(serialization.TypePortable, 0, 0, 0, 1, 0, 0, 0, 2, 0, 0, 0, 3)
+	side, err := inferMappingSide([]dataSample{{Data: d, Value: p}}, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, []mappingOption{
+		{Name: "valueFormat", Value: "portable"},
+		{Name: "valuePortableFactoryId", Value: "1"},
+		{Name: "valuePortableClassId", Value: "2"},
+		{Name: "valuePortableClassVersion", Value: "3"},
+	}, side.Options)
+	require.Equal(t, []mappingColumn{
+		{Name: "id", Type: "BIGINT"},
+		{Name: "initial", Type: "VARCHAR"},
+	}, side.Columns)
+	require.Equal(t, []string{"scores"}, side.Skipped)
+}
+
+func TestCreateMappingStatement(t *testing.T) {
+	key := mappingSide{
+		Options: []mappingOption{{Name: "keyFormat", Value: "json-flat"}},
+		Columns: []mappingColumn{
+			{Name: "id", Type: "BIGINT", ExternalName: "__key.id"},
+			{Name: "region", Type: "VARCHAR", ExternalName: "__key.region"},
+		},
+	}
+	value := mappingSide{
+		Options: []mappingOption{{Name: "valueFormat", Value: "compact"}, {Name: "valueCompactTypeName", Value: "it's"}},
+		Columns: []mappingColumn{{Name: "id", Type: "INTEGER"}, {Name: `na"me`, Type: "VARCHAR"}},
+	}
+	target := `CREATE MAPPING "my-map" (
+  "key_id" BIGINT EXTERNAL NAME "__key.id",
+  "region" VARCHAR EXTERNAL NAME "__key.region",
+  "id" INTEGER,
+  "na""me" VARCHAR
+)
+TYPE IMap
+OPTIONS (
+  'keyFormat' = 'json-flat',
+  'valueFormat' = 'compact',
+  'valueCompactTypeName' = 'it''s'
+);`
+	require.Equal(t, target, createMappingStatement("my-map", key, value))
+}
+
+func testData(t int32, payload ...byte) hazelcast.Data {
+	d := make([]byte, serialization.DataHeaderSize, serialization.DataHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(d[4:], uint32(t))
+	return append(d, payload...)
+}
+
+func testJSONSample(s string) dataSample {
+	return dataSample{
+		Data:  testData(serialization.TypeJSONSerialization),
+		Value: pubserialization.JSON(s),
+	}
+}
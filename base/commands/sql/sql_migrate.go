@@ -0,0 +1,161 @@
+//go:build std || sql
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	clcsql "github.com/hazelcast/hazelcast-commandline-client/clc/sql"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+type MigrateCommand struct{}
+
+func (MigrateCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("migrate")
+	long := fmt.Sprintf(`Applies the pending SQL migrations in the migration directory
+
+A migration is a file in the migration directory containing the SQL statements separated by semicolons.
+The name of a migration file starts with its version number and has the .sql extension,
+e.g., 001_create_mappings.sql or V2__add_views.sql.
+
+The migrations which were not applied before are applied in the order of their versions.
+The version, the checksum and the installation time of each applied migration are recorded in the --history-map Map.
+The migrations are validated before applying the pending ones, see the "sql migrate validate" command.
+The history Map is locked while the migrations are applied, so only one migration runs at a time.
+
+A migration is recorded only after all of its statements are executed successfully.
+If a statement fails, the statements before it in the same migration are not rolled back,
+so prefer the statements which can be re-run, such as CREATE OR REPLACE MAPPING.
+
+This command requires a Viridian or a Hazelcast cluster
+having version %s or better.
+`, minServerVersion)
+	short := "Applies the pending SQL migrations in the migration directory"
+	cc.SetCommandHelp(long, short)
+	cc.AddStringFlag(flagDir, "", defaultMigrationDir, false, "directory which contains the migration files")
+	cc.AddStringFlag(flagHistoryMap, "", defaultHistoryMap, false, "name of the Map which keeps the history of the applied migrations")
+	return nil
+}
+
+func (MigrateCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	local, err := loadMigrations(ec.Props().GetString(flagDir))
+	if err != nil {
+		return err
+	}
+	appliedV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		hm, err := historyMap(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		// the history map is locked, so concurrent runs do not apply the same migrations
+		ctx = hm.NewLockContext(ctx)
+		locked, err := hm.TryLock(ctx, migrationLockKey)
+		if err != nil {
+			return nil, fmt.Errorf("locking the migration history: %w", err)
+		}
+		if !locked {
+			return nil, fmt.Errorf("another migration is in progress, %s is locked", hm.Name())
+		}
+		defer func() {
+			// the lock should be released even if the command was cancelled
+			if err := hm.Unlock(context.WithoutCancel(ctx), migrationLockKey); err != nil {
+				ec.Logger().Error(fmt.Errorf("unlocking the migration history: %w", err))
+			}
+		}()
+		sp.SetText("Validating the migrations")
+		applied, err := appliedMigrations(ctx, hm)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateMigrations(migrationStatuses(local, applied)); err != nil {
+			return nil, err
+		}
+		pending, err := pendingMigrations(local, applied)
+		if err != nil {
+			return nil, err
+		}
+		var done []migrationStatus
+		for i, m := range pending {
+			sp.SetText(fmt.Sprintf("Applying migration %d of %d: %s", i+1, len(pending), m.Script))
+			if err := applyMigration(ctx, ec, hm, m); err != nil {
+				if len(done) > 0 {
+					return nil, fmt.Errorf("%w (applied before the failure: %s)", err, migrationNames(done))
+				}
+				return nil, err
+			}
+			done = append(done, migrationStatus{
+				Version:     m.Version,
+				Description: m.Description,
+				Script:      m.Script,
+				State:       migrationStateApplied,
+			})
+		}
+		return done, nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	applied := appliedV.([]migrationStatus)
+	if len(applied) == 0 {
+		ec.PrintlnUnnecessary("OK There are no pending migrations.")
+		return nil
+	}
+	for _, m := range applied {
+		ec.PrintlnUnnecessary(fmt.Sprintf("OK Applied migration %d (%s).", m.Version, m.Script))
+	}
+	return nil
+}
+
+// migrationNames returns the versions and the scripts of the given migrations as a comma separated list.
+func migrationNames(ms []migrationStatus) string {
+	names := make([]string, len(ms))
+	for i, m := range ms {
+		names[i] = fmt.Sprintf("%d (%s)", m.Version, m.Script)
+	}
+	return strings.Join(names, ", ")
+}
+
+// applyMigration executes the statements in the given migration and records it in the history map.
+func applyMigration(ctx context.Context, ec plug.ExecContext, hm *hazelcast.Map, m migration) error {
+	stmts, err := clcsql.SplitStatements(m.Text)
+	if err != nil {
+		return fmt.Errorf("migration %d (%s): %w", m.Version, m.Script, err)
+	}
+	start := time.Now()
+	for i, stmt := range stmts {
+		res, err := clcsql.ExecSQL(ctx, ec, stmt)
+		if err != nil {
+			return fmt.Errorf("migration %d (%s), statement %d: %w", m.Version, m.Script, i+1, err)
+		}
+		res.Close()
+	}
+	return recordMigration(ctx, hm, m, start, time.Since(start))
+}
+
+// historyMap connects to the cluster and returns the migration history Map.
+func historyMap(ctx context.Context, ec plug.ExecContext, sp clc.Spinner) (*hazelcast.Map, error) {
+	ci, err := cmd.ClientInternal(ctx, ec, sp)
+	if err != nil {
+		return nil, err
+	}
+	cmd.IncrementClusterMetric(ctx, ec, "total.sql")
+	if sv, ok := cmd.CheckServerCompatible(ci, minServerVersion); !ok {
+		return nil, fmt.Errorf("server (%s) does not support this command, at least %s is expected", sv, minServerVersion)
+	}
+	sp.SetText("Reading the migration history")
+	return ci.Client().GetMap(ctx, ec.Props().GetString(flagHistoryMap))
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("sql:migrate", &MigrateCommand{}))
+}
@@ -0,0 +1,261 @@
+//go:build std || sql
+
+package sql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	pubserialization "github.com/hazelcast/hazelcast-go-client/serialization"
+
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+)
+
+const (
+	flagDir             = "dir"
+	flagHistoryMap      = "history-map"
+	defaultMigrationDir = "migrations"
+	defaultHistoryMap   = "__clc.sql.migrations"
+	// migrationLockKey is the key locked in the history map while the migrations are applied
+	migrationLockKey = "__lock"
+)
+
+const (
+	migrationStateApplied = "applied"
+	migrationStatePending = "pending"
+	migrationStateChanged = "changed"
+	migrationStateMissing = "missing"
+)
+
+// migrationFileRe matches the migration file names, such as 001_create_mappings.sql or V2__add_view.sql.
+var migrationFileRe = regexp.MustCompile(`^[Vv]?(\d+)(?:[_\-. ]+(.*))?\.sql$`)
+
+// migration is a versioned SQL migration file.
+type migration struct {
+	Version     int64
+	Description string
+	Script      string
+	Checksum    string
+	Text        string
+}
+
+// appliedMigration is the record of an applied migration in the history map.
+type appliedMigration struct {
+	Version         int64  `json:"version"`
+	Description     string `json:"description"`
+	Script          string `json:"script"`
+	Checksum        string `json:"checksum"`
+	InstalledOn     string `json:"installedOn"`
+	ExecutionTimeMs int64  `json:"executionTimeMs"`
+}
+
+// migrationStatus is the state of a migration, comparing the migration files with the history.
+type migrationStatus struct {
+	Version     int64
+	Description string
+	Script      string
+	State       string
+	InstalledOn string
+}
+
+// loadMigrations reads the migration files in the given directory, sorted by their versions.
+// Files without the .sql extension are ignored.
+func loadMigrations(dir string) ([]migration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading the migration directory: %w", err)
+	}
+	var ms []migration
+	scripts := map[int64]string{}
+	for _, e := range entries {
+		if e.IsDir() || !strings.EqualFold(filepath.Ext(e.Name()), ".sql") {
+			continue
+		}
+		m, err := parseMigrationName(e.Name())
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := scripts[m.Version]; ok {
+			return nil, fmt.Errorf("migrations %s and %s have the same version: %d", other, m.Script, m.Version)
+		}
+		scripts[m.Version] = m.Script
+		b, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading migration %s: %w", e.Name(), err)
+		}
+		m.Text = string(b)
+		m.Checksum = migrationChecksum(b)
+		ms = append(ms, m)
+	}
+	sort.Slice(ms, func(i, j int) bool {
+		return ms[i].Version < ms[j].Version
+	})
+	return ms, nil
+}
+
+// parseMigrationName returns the migration with the version and the description in the given file name.
+func parseMigrationName(name string) (migration, error) {
+	ms := migrationFileRe.FindStringSubmatch(name)
+	if ms == nil {
+		return migration{}, fmt.Errorf("invalid migration file name: %s, the name must start with the version number, e.g., 001_create_mappings.sql", name)
+	}
+	v, err := strconv.ParseInt(ms[1], 10, 64)
+	if err != nil {
+		return migration{}, fmt.Errorf("invalid version of migration %s: %w", name, err)
+	}
+	desc := strings.TrimSpace(strings.NewReplacer("_", " ", "-", " ").Replace(ms[2]))
+	return migration{Version: v, Description: desc, Script: name}, nil
+}
+
+func migrationChecksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// migrationStatuses compares the migration files with the applied migrations.
+// The statuses are sorted by version.
+func migrationStatuses(local []migration, applied []appliedMigration) []migrationStatus {
+	byVersion := make(map[int64]appliedMigration, len(applied))
+	for _, a := range applied {
+		byVersion[a.Version] = a
+	}
+	sts := make([]migrationStatus, 0, len(local)+len(applied))
+	for _, m := range local {
+		st := migrationStatus{
+			Version:     m.Version,
+			Description: m.Description,
+			Script:      m.Script,
+			State:       migrationStatePending,
+		}
+		if a, ok := byVersion[m.Version]; ok {
+			delete(byVersion, m.Version)
+			st.InstalledOn = a.InstalledOn
+			st.State = migrationStateApplied
+			if a.Checksum != m.Checksum {
+				st.State = migrationStateChanged
+			}
+		}
+		sts = append(sts, st)
+	}
+	for _, a := range byVersion {
+		sts = append(sts, migrationStatus{
+			Version:     a.Version,
+			Description: a.Description,
+			Script:      a.Script,
+			State:       migrationStateMissing,
+			InstalledOn: a.InstalledOn,
+		})
+	}
+	sort.Slice(sts, func(i, j int) bool {
+		return sts[i].Version < sts[j].Version
+	})
+	return sts
+}
+
+// validateMigrations returns an error if an applied migration was changed or removed.
+func validateMigrations(sts []migrationStatus) error {
+	var problems []string
+	for _, st := range sts {
+		switch st.State {
+		case migrationStateChanged:
+			problems = append(problems, fmt.Sprintf("migration %d (%s) was changed after it was applied", st.Version, st.Script))
+		case migrationStateMissing:
+			problems = append(problems, fmt.Sprintf("migration %d (%s) was applied but its file is missing", st.Version, st.Script))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("validation failed: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// pendingMigrations returns the migrations which are not applied yet.
+// Returns an error if a pending migration has a version older than the latest applied migration.
+func pendingMigrations(local []migration, applied []appliedMigration) ([]migration, error) {
+	done := make(map[int64]struct{}, len(applied))
+	var latest int64 = -1
+	for _, a := range applied {
+		done[a.Version] = struct{}{}
+		if a.Version > latest {
+			latest = a.Version
+		}
+	}
+	var pending []migration
+	for _, m := range local {
+		if _, ok := done[m.Version]; ok {
+			continue
+		}
+		if m.Version < latest {
+			return nil, fmt.Errorf("migration %d (%s) is older than the latest applied migration %d", m.Version, m.Script, latest)
+		}
+		pending = append(pending, m)
+	}
+	return pending, nil
+}
+
+// appliedMigrations returns the applied migrations in the given history map.
+func appliedMigrations(ctx context.Context, m *hazelcast.Map) ([]appliedMigration, error) {
+	es, err := m.GetEntrySet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading the migration history: %w", err)
+	}
+	ams := make([]appliedMigration, 0, len(es))
+	for _, e := range es {
+		b, ok := e.Value.(pubserialization.JSON)
+		if !ok {
+			return nil, fmt.Errorf("invalid migration history entry with key: %v", e.Key)
+		}
+		var am appliedMigration
+		if err := json.Unmarshal(b, &am); err != nil {
+			return nil, fmt.Errorf("decoding the migration history entry with key %v: %w", e.Key, err)
+		}
+		ams = append(ams, am)
+	}
+	return ams, nil
+}
+
+// recordMigration adds the given migration to the history map.
+func recordMigration(ctx context.Context, hm *hazelcast.Map, m migration, installedOn time.Time, took time.Duration) error {
+	am := appliedMigration{
+		Version:         m.Version,
+		Description:     m.Description,
+		Script:          m.Script,
+		Checksum:        m.Checksum,
+		InstalledOn:     installedOn.UTC().Format(time.RFC3339),
+		ExecutionTimeMs: took.Milliseconds(),
+	}
+	b, err := json.Marshal(am)
+	if err != nil {
+		return err
+	}
+	if err := hm.Set(ctx, m.Version, pubserialization.JSON(b)); err != nil {
+		return fmt.Errorf("recording migration %d: %w", m.Version, err)
+	}
+	return nil
+}
+
+func migrationStatusRows(sts []migrationStatus) []output.Row {
+	rows := make([]output.Row, len(sts))
+	for i, st := range sts {
+		rows[i] = output.Row{
+			output.Column{Name: "Version", Type: serialization.TypeInt64, Value: st.Version},
+			output.Column{Name: "Description", Type: serialization.TypeString, Value: st.Description},
+			output.Column{Name: "Script", Type: serialization.TypeString, Value: st.Script},
+			output.Column{Name: "State", Type: serialization.TypeString, Value: st.State},
+			output.Column{Name: "Installed On", Type: serialization.TypeString, Value: st.InstalledOn},
+		}
+	}
+	return rows
+}
@@ -0,0 +1,82 @@
+//go:build std || sql
+
+package sql
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMigrationName(t *testing.T) {
+	testCases := []struct {
+		name        string
+		version     int64
+		description string
+	}{
+		{name: "001_create_mappings.sql", version: 1, description: "create mappings"},
+		{name: "V2__add-views.sql", version: 2, description: "add views"},
+		{name: "10.sql", version: 10, description: ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := parseMigrationName(tc.name)
+			require.NoError(t, err)
+			require.Equal(t, tc.version, m.Version)
+			require.Equal(t, tc.description, m.Description)
+			require.Equal(t, tc.name, m.Script)
+		})
+	}
+	_, err := parseMigrationName("create.sql")
+	require.Error(t, err)
+}
+
+func TestLoadMigrations(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "10_second.sql"), []byte("SELECT 2;"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "9_first.sql"), []byte("SELECT 1;"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a migration"), 0600))
+	ms, err := loadMigrations(dir)
+	require.NoError(t, err)
+	require.Len(t, ms, 2)
+	require.Equal(t, "9_first.sql", ms[0].Script)
+	require.Equal(t, "10_second.sql", ms[1].Script)
+	require.Equal(t, migrationChecksum([]byte("SELECT 1;")), ms[0].Checksum)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "009_duplicate.sql"), nil, 0600))
+	_, err = loadMigrations(dir)
+	require.EqualError(t, err, "migrations 009_duplicate.sql and 9_first.sql have the same version: 9")
+}
+
+func TestMigrationStatuses(t *testing.T) {
+	local := []migration{
+		{Version: 1, Script: "1.sql", Checksum: "a"},
+		{Version: 2, Script: "2.sql", Checksum: "b"},
+		{Version: 4, Script: "4.sql", Checksum: "d"},
+	}
+	applied := []appliedMigration{
+		{Version: 1, Script: "1.sql", Checksum: "a", InstalledOn: "t1"},
+		{Version: 2, Script: "2.sql", Checksum: "x", InstalledOn: "t2"},
+		{Version: 3, Script: "3.sql", Checksum: "c", InstalledOn: "t3"},
+	}
+	sts := migrationStatuses(local, applied)
+	require.Equal(t, []migrationStatus{
+		{Version: 1, Script: "1.sql", State: migrationStateApplied, InstalledOn: "t1"},
+		{Version: 2, Script: "2.sql", State: migrationStateChanged, InstalledOn: "t2"},
+		{Version: 3, Script: "3.sql", State: migrationStateMissing, InstalledOn: "t3"},
+		{Version: 4, Script: "4.sql", State: migrationStatePending},
+	}, sts)
+	err := validateMigrations(sts)
+	require.EqualError(t, err, "validation failed: migration 2 (2.sql) was changed after it was applied; migration 3 (3.sql) was applied but its file is missing")
+	require.NoError(t, validateMigrations(sts[:1]))
+}
+
+func TestPendingMigrations(t *testing.T) {
+	local := []migration{{Version: 1}, {Version: 2, Script: "2.sql"}, {Version: 3}}
+	pending, err := pendingMigrations(local, []appliedMigration{{Version: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []migration{{Version: 2, Script: "2.sql"}, {Version: 3}}, pending)
+	_, err = pendingMigrations(local, []appliedMigration{{Version: 1}, {Version: 3}})
+	require.EqualError(t, err, "migration 2 (2.sql) is older than the latest applied migration 3")
+}
@@ -0,0 +1,55 @@
+//go:build std || sql
+
+package sql
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+type MigrateStatusCommand struct{}
+
+func (MigrateStatusCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("status")
+	long := `Displays the state of the SQL migrations
+
+The state of a migration is one of:
+  * applied: the migration was applied.
+  * pending: the migration was not applied yet.
+  * changed: the migration file was changed after the migration was applied.
+  * missing: the migration was applied, but its file is not in the migration directory.
+`
+	short := "Displays the state of the SQL migrations"
+	cc.SetCommandHelp(long, short)
+	return nil
+}
+
+func (MigrateStatusCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	local, err := loadMigrations(ec.Props().GetString(flagDir))
+	if err != nil {
+		return err
+	}
+	stsV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		hm, err := historyMap(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		applied, err := appliedMigrations(ctx, hm)
+		if err != nil {
+			return nil, err
+		}
+		return migrationStatuses(local, applied), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return ec.AddOutputRows(ctx, migrationStatusRows(stsV.([]migrationStatus))...)
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("sql:migrate:status", &MigrateStatusCommand{}))
+}
@@ -0,0 +1,57 @@
+//go:build std || sql
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+type MigrateValidateCommand struct{}
+
+func (MigrateValidateCommand) Init(cc plug.InitContext) error {
+	cc.SetCommandUsage("validate")
+	long := `Validates the applied SQL migrations against the migration files
+
+The validation fails if a migration file was changed after the migration was applied,
+or the file of an applied migration is not in the migration directory.
+`
+	short := "Validates the applied SQL migrations against the migration files"
+	cc.SetCommandHelp(long, short)
+	return nil
+}
+
+func (MigrateValidateCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
+	local, err := loadMigrations(ec.Props().GetString(flagDir))
+	if err != nil {
+		return err
+	}
+	countV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		hm, err := historyMap(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		applied, err := appliedMigrations(ctx, hm)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateMigrations(migrationStatuses(local, applied)); err != nil {
+			return nil, err
+		}
+		return len(applied), nil
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	ec.PrintlnUnnecessary(fmt.Sprintf("OK Validated %d applied migrations.", countV.(int)))
+	return nil
+}
+
+func init() {
+	check.Must(plug.Registry.RegisterCommand("sql:migrate:validate", &MigrateValidateCommand{}))
+}
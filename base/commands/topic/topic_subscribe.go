@@ -47,7 +47,7 @@ func (SubscribeCommand) Exec(ctx context.Context, ec plug.ExecContext) error {
 		}
 		cmd.IncrementClusterMetric(ctx, ec, "total.topic")
 		sp.SetText(fmt.Sprintf("Listening to messages of topic %s", name))
-		sid, err := addListener(ctx, ci, name, ec.Logger(), func(event TopicEvent) {
+		sid, err := addListener(ctx, ci, name, ec.Logger(), ec.Props().GetBool(base.FlagRaw), func(event TopicEvent) {
 			select {
 			case events <- event:
 			case <-ctx.Done():
@@ -84,7 +84,7 @@ func newTopicEvent(name string, value any, valueType int32, publishTime time.Tim
 	}
 }
 
-func addListener(ctx context.Context, ci *hazelcast.ClientInternal, topic string, logger log.Logger, handler func(event TopicEvent)) (types.UUID, error) {
+func addListener(ctx context.Context, ci *hazelcast.ClientInternal, topic string, logger log.Logger, raw bool, handler func(event TopicEvent)) (types.UUID, error) {
 	subscriptionID := types.NewUUID()
 	addRequest := codec.EncodeTopicAddMessageListenerRequest(topic, false)
 	removeRequest := codec.EncodeTopicRemoveMessageListenerRequest(topic, subscriptionID)
@@ -94,7 +94,7 @@ func addListener(ctx context.Context, ci *hazelcast.ClientInternal, topic string
 			item, err := ci.DecodeData(itemData)
 			if err != nil {
 				logger.Warn("The value was not decoded, due to error: %s", err.Error())
-				item = serialization.NondecodedType(serialization.TypeToLabel(itemType))
+				item = serialization.NondecodedValue(itemData, raw)
 			}
 			var member cluster.MemberInfo
 			if m := ci.ClusterService().GetMemberByUUID(uuid); m != nil {
@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/internal"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+const ValuesFileHelp = `The format of the file is determined by its extension:
+  * .csv: Each record is a JSON object. The first record is the header which contains the field names.
+    The field values are strings.
+  * .ndjson, .jsonl: Each non-empty line is a JSON value.
+The values in the file are always JSON, so --value-type cannot be used with --file.`
+
+// CheckValuesFileValueType returns an error if a value type other than JSON is given together with a values file.
+// The default value type, string, is accepted, since it cannot be told apart from the value type not being given.
+func CheckValuesFileValueType(ec plug.ExecContext, fileFlag string) error {
+	switch ec.Props().GetString(base.FlagValueType) {
+	case internal.TypeNameString, internal.TypeNameJSON, "":
+		return nil
+	}
+	return fmt.Errorf("--%s cannot be used with --%s, the values in the file are JSON", base.FlagValueType, fileFlag)
+}
+
+type valuesReadFunc func(r io.Reader, fn func(v serialization.JSON) error) error
+
+// ReadValuesFile calls fn with each value in the given CSV or NDJSON file.
+func ReadValuesFile(path string, fn func(v serialization.JSON) error) error {
+	var read valuesReadFunc
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		read = readCSVValues
+	case ".ndjson", ".jsonl":
+		read = readNDJSONValues
+	default:
+		return fmt.Errorf("unknown file format: %s (should be one of: .csv, .ndjson, .jsonl)", ext)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return read(f, fn)
+}
+
+// readCSVValues calls fn with a JSON object for each record.
+// The first record is the header, which contains the field names.
+func readCSVValues(r io.Reader, fn func(v serialization.JSON) error) error {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+	for {
+		rec, err := cr.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		obj := make(map[string]string, len(header))
+		for i, field := range header {
+			obj[field] = rec[i]
+		}
+		b, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if err := fn(b); err != nil {
+			return err
+		}
+	}
+}
+
+// readNDJSONValues calls fn with a JSON value for each non-empty line.
+func readNDJSONValues(r io.Reader, fn func(v serialization.JSON) error) error {
+	sc := bufio.NewScanner(r)
+	var line int
+	for sc.Scan() {
+		line++
+		b := sc.Bytes()
+		if len(bytes.TrimSpace(b)) == 0 {
+			continue
+		}
+		if !json.Valid(b) {
+			return fmt.Errorf("malformed JSON at line %d", line)
+		}
+		v := make(serialization.JSON, len(b))
+		copy(v, b)
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+	return sc.Err()
+}
@@ -0,0 +1,108 @@
+package commands_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base"
+	"github.com/hazelcast/hazelcast-commandline-client/base/commands"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/it"
+)
+
+func TestReadValuesFile(t *testing.T) {
+	testCases := []struct {
+		name     string
+		file     string
+		text     string
+		expected []string
+		errText  string
+	}{
+		{
+			name:     "csv",
+			file:     "values.csv",
+			text:     "id,name\n1,foo\n2,\"bar, baz\"\n",
+			expected: []string{`{"id":"1","name":"foo"}`, `{"id":"2","name":"bar, baz"}`},
+		},
+		{
+			name: "csv header only",
+			file: "values.csv",
+			text: "id,name\n",
+		},
+		{
+			name:    "csv wrong number of fields",
+			file:    "values.csv",
+			text:    "id,name\n1\n",
+			errText: "wrong number of fields",
+		},
+		{
+			name:     "ndjson",
+			file:     "values.ndjson",
+			text:     "{\"id\": 1}\n\n  \n[1, 2]\n",
+			expected: []string{`{"id": 1}`, `[1, 2]`},
+		},
+		{
+			name:     "jsonl",
+			file:     "values.JSONL",
+			text:     "\"foo\"",
+			expected: []string{`"foo"`},
+		},
+		{
+			name:    "ndjson malformed",
+			file:    "values.ndjson",
+			text:    "{\"id\": 1}\n{\"id\":\n",
+			errText: "malformed JSON at line 2",
+		},
+		{
+			name:    "unknown format",
+			file:    "values.txt",
+			text:    "foo\n",
+			errText: "unknown file format: .txt",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.file)
+			require.NoError(t, os.WriteFile(path, []byte(tc.text), 0600))
+			var values []string
+			err := commands.ReadValuesFile(path, func(v serialization.JSON) error {
+				values = append(values, string(v))
+				return nil
+			})
+			if tc.errText != "" {
+				require.ErrorContains(t, err, tc.errText)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, values)
+		})
+	}
+}
+
+func TestCheckValuesFileValueType(t *testing.T) {
+	testCases := []struct {
+		valueType string
+		errText   string
+	}{
+		{valueType: ""},
+		{valueType: "string"},
+		{valueType: "json"},
+		{valueType: "int64", errText: "--value-type cannot be used with --file, the values in the file are JSON"},
+		{valueType: "compact:com.acme.Order", errText: "--value-type cannot be used with --file, the values in the file are JSON"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.valueType, func(t *testing.T) {
+			ec := it.NewExecuteContext(nil)
+			ec.Set(base.FlagValueType, tc.valueType)
+			err := commands.CheckValuesFileValueType(ec, "file")
+			if tc.errText != "" {
+				require.EqualError(t, err, tc.errText)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
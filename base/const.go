@@ -3,6 +3,7 @@ package base
 const (
 	FlagName      = "name"
 	FlagShowType  = "show-type"
+	FlagRaw       = "raw"
 	FlagKeyType   = "key-type"
 	FlagValueType = "value-type"
 	DefaultName   = "default"
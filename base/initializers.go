@@ -3,13 +3,17 @@
 package base
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	"golang.org/x/exp/slices"
 
 	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/config"
 	"github.com/hazelcast/hazelcast-commandline-client/clc/paths"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
 )
@@ -25,6 +29,7 @@ func (g GlobalInitializer) Init(cc plug.InitContext) error {
 	lp := paths.DefaultLogPath(time.Now())
 	if !cc.Interactive() {
 		cc.AddStringFlag(clc.PropertyConfig, clc.ShortcutConfig, "", false, "set the configuration")
+		cc.SetFlagCompletion(clc.PropertyConfig, completeConfigs)
 		cc.AddStringFlag(clc.PropertyLogPath, "", lp, false, "set the log path, use stderr to log to stderr")
 		cc.AddStringFlag(clc.PropertyLogLevel, "", "info", false, "set the log level")
 	}
@@ -37,6 +42,15 @@ func (g GlobalInitializer) Init(cc plug.InitContext) error {
 	return nil
 }
 
+// completeConfigs suggests the names of the configurations in the configuration directory.
+func completeConfigs(ctx context.Context, ec plug.ExecContext) ([]string, error) {
+	cs, err := config.FindAll(paths.Configs())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return cs, err
+}
+
 func updateFormatFlag(cc plug.InitContext) {
 	pns := plug.Registry.PrinterNames()
 	slices.Sort(pns)
@@ -25,9 +25,31 @@ func GetAll(ctx context.Context, ec plug.ExecContext, typeFilter string, showHid
 		return nil, err
 	}
 	stop()
+	return Filter(objs.([]types.DistributedObjectInfo), typeFilter, showHidden), nil
+}
+
+// NameCompletion returns a completion function which suggests the names of the objects having the given type.
+func NameCompletion(typeFilter string) plug.CompletionFunc {
+	return func(ctx context.Context, ec plug.ExecContext) ([]string, error) {
+		objs, err := GetAll(ctx, ec, typeFilter, false)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, len(objs))
+		for i, o := range objs {
+			names[i] = o.Name
+		}
+		return names, nil
+	}
+}
+
+// Filter returns the objects having the given type, sorted by type and name.
+// All objects are returned if typeFilter is empty.
+// The hidden objects are included only if showHidden is true.
+func Filter(objs []types.DistributedObjectInfo, typeFilter string, showHidden bool) []types.DistributedObjectInfo {
 	var r []types.DistributedObjectInfo
 	typeFilter = strings.ToLower(typeFilter)
-	for _, o := range objs.([]types.DistributedObjectInfo) {
+	for _, o := range objs {
 		if !showHidden && (o.Name == "" || strings.HasPrefix(o.Name, "__")) {
 			continue
 		}
@@ -54,7 +76,7 @@ func GetAll(ctx context.Context, ec plug.ExecContext, typeFilter string, showHid
 		}
 		return ri.Name < rj.Name
 	})
-	return r, nil
+	return r
 }
 
 func ShortType(svcName string) string {
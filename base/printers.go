@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/terminal"
@@ -11,7 +12,7 @@ import (
 
 const (
 	PrinterDelimited = "delimited"
-	PrinterJSON      = "json"
+	PrinterJSON      = clc.FormatJSON
 	PrinterTable     = "table"
 	PrinterCSV       = "csv"
 )
@@ -180,12 +180,16 @@ func (m *Main) Execute(ctx context.Context, args ...string) error {
 	err = m.root.ExecuteContext(ctx)
 	m.props.Pop()
 	// set all flags to their defaults
-	// XXX: it may not work with slices, see: https://github.com/spf13/cobra/issues/1488#issuecomment-1205104931
 	if cm != nil {
 		cm.Flags().VisitAll(func(f *pflag.Flag) {
 			if f.Changed {
-				// ignoring the error
-				_ = f.Value.Set(f.DefValue)
+				// ignoring the errors
+				if sv, ok := f.Value.(pflag.SliceValue); ok {
+					// setting a slice flag appends to the existing items
+					_ = sv.Replace(nil)
+				} else {
+					_ = f.Value.Set(f.DefValue)
+				}
 				f.Changed = false
 			}
 		})
@@ -252,7 +256,7 @@ func (m *Main) runInitializers(cc *CommandContext) error {
 		}
 	}
 	addUniqueCommandGroup(cc, m.root)
-	return nil
+	return m.registerCompletions("", cc)
 }
 
 func (m *Main) createCommands() error {
@@ -282,6 +286,10 @@ func (m *Main) createCommands() error {
 				p = &cobra.Command{
 					Use: fmt.Sprintf("%s {command} [flags]", ps[i-1]),
 				}
+				// the parent may not be registered in the interactive mode, add the backslash prefix in that case
+				if m.mode != plug.ModeNonInteractive && parent == m.root {
+					p.Use = fmt.Sprintf("\\%s", p.Use)
+				}
 				p.SetUsageTemplate(usageTemplate)
 				m.cmds[name] = p
 				parent.AddCommand(p)
@@ -304,6 +312,9 @@ func (m *Main) createCommands() error {
 			}
 		}
 		addUniqueCommandGroup(cc, parent)
+		if err := m.registerCompletions(c.Name, cc); err != nil {
+			return err
+		}
 		if !cc.TopLevel() {
 			cmd.Args = cc.ArgsFunc()
 			cmd.Use = cc.GetCommandUsage()
@@ -409,6 +420,8 @@ func convertFlagValue(fs *pflag.FlagSet, name string, v pflag.Value) any {
 		return check.MustValue(fs.GetBool(name))
 	case "int64":
 		return check.MustValue(fs.GetInt64(name))
+	case "stringArray":
+		return check.MustValue(fs.GetStringArray(name))
 	}
 	panic(fmt.Errorf("cannot convert type: %s", v.Type()))
 }
@@ -438,6 +451,6 @@ func addUniqueCommandGroup(cc *CommandContext, parent *cobra.Command) {
 }
 
 func init() {
-	hazelcast.SetDefaultCompactDeserializer(serialization.GenericCompactDeserializer{})
+	hazelcast.SetDefaultCompactDeserializer(serialization.GenericCompactDeserializer{SchemaDir: paths.Schemas})
 	hazelcast.SetDefaultPortableDeserializer(serialization.NewGenericPortableSerializer())
 }
@@ -42,16 +42,20 @@ type CommandContext struct {
 	group        *cobra.Group
 	argSpecs     []ArgSpec
 	usage        string
+	// flagCompletions contains the completion functions of the flags
+	flagCompletions map[string]plug.CompletionFunc
+	argCompletion   plug.CompletionFunc
 }
 
 func NewCommandContext(cmd *cobra.Command, cfgProvider config.Provider, mode plug.Mode) *CommandContext {
 	return &CommandContext{
-		Cmd:          cmd,
-		CP:           cfgProvider,
-		stringValues: map[string]*string{},
-		boolValues:   map[string]*bool{},
-		intValues:    map[string]*int64{},
-		mode:         mode,
+		Cmd:             cmd,
+		CP:              cfgProvider,
+		stringValues:    map[string]*string{},
+		boolValues:      map[string]*bool{},
+		intValues:       map[string]*int64{},
+		mode:            mode,
+		flagCompletions: map[string]plug.CompletionFunc{},
 	}
 }
 
@@ -64,6 +68,15 @@ func (cc *CommandContext) AddStringFlag(long, short, value string, required bool
 	cc.stringValues[long] = &s
 }
 
+// AddStringSliceFlag adds a flag which can be given more than once.
+// The values are not split by commas.
+func (cc *CommandContext) AddStringSliceFlag(long, short string, required bool, help string) {
+	cc.Cmd.PersistentFlags().StringArrayP(long, short, nil, help)
+	if required {
+		check.Must(cc.Cmd.MarkPersistentFlagRequired(long))
+	}
+}
+
 func (cc *CommandContext) AddIntFlag(long, short string, value int64, required bool, help string) {
 	var i int64
 	cc.Cmd.PersistentFlags().Int64VarP(&value, long, short, value, help)
@@ -191,6 +204,16 @@ func (cc *CommandContext) TopLevel() bool {
 	return cc.isTopLevel
 }
 
+// SetFlagCompletion sets the function which returns the suggestions for the value of the given flag.
+func (cc *CommandContext) SetFlagCompletion(flag string, f plug.CompletionFunc) {
+	cc.flagCompletions[flag] = f
+}
+
+// SetArgCompletion sets the function which returns the suggestions for the first argument.
+func (cc *CommandContext) SetArgCompletion(f plug.CompletionFunc) {
+	cc.argCompletion = f
+}
+
 func (cc *CommandContext) ArgsFunc() func(*cobra.Command, []string) error {
 	if len(cc.argSpecs) == 0 {
 		return cobra.NoArgs
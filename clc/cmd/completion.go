@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/paths"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/store"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+const (
+	completionTimeout  = 5 * time.Second
+	completionCacheTTL = 30 * time.Second
+)
+
+// registerCompletions registers the completion functions set in the command context to the command.
+// name is used to identify the cached suggestions.
+func (m *Main) registerCompletions(name string, cc *CommandContext) error {
+	for flag, f := range cc.flagCompletions {
+		// the flag may not exist in the current mode, e.g., --config in the interactive mode
+		if cc.Cmd.Flag(flag) == nil {
+			continue
+		}
+		key := fmt.Sprintf("%s:--%s", name, flag)
+		if err := cc.Cmd.RegisterFlagCompletionFunc(flag, m.completionFunc(key, f)); err != nil {
+			return fmt.Errorf("registering the completion of --%s: %w", flag, err)
+		}
+	}
+	if cc.argCompletion != nil {
+		f := m.completionFunc(name, cc.argCompletion)
+		cc.Cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return f(cmd, args, toComplete)
+		}
+	}
+	return nil
+}
+
+// completionFunc converts the given completion function to a cobra completion function.
+// The suggestions which required connecting to the cluster are cached briefly, so completion stays fast.
+func (m *Main) completionFunc(key string, f plug.CompletionFunc) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		m.props.Push()
+		defer m.props.Pop()
+		cfs := cmd.Flags()
+		cfs.VisitAll(func(f *pflag.Flag) {
+			// skip managed flags
+			if f.Name == clc.PropertyConfig || f.Name == clc.PropertyLogPath || f.Name == clc.PropertyLogLevel {
+				return
+			}
+			m.props.Set(f.Name, convertFlagValue(cfs, f.Name, f.Value))
+		})
+		// the spinner and the other messages must not be mixed with the suggestions
+		m.props.Set(clc.PropertyQuiet, true)
+		// the configuration is a part of the key, since the suggestions may be different for each cluster
+		ck := fmt.Sprintf("%s:%s", m.props.GetString(clc.PropertyConfig), key)
+		sa := store.NewStoreAccessor(filepath.Join(paths.Caches(), "completion"), m.lg)
+		ss, ok := m.cachedSuggestions(sa, ck)
+		if !ok {
+			var err error
+			ss, err = m.suggestions(cmd, f)
+			if err != nil {
+				m.lg.Debugf("Completing %s: %s", ck, err.Error())
+				return nil, cobra.ShellCompDirectiveError
+			}
+			// only the suggestions from the cluster are cached
+			if m.clientInternal() != nil {
+				m.cacheSuggestions(sa, ck, ss)
+			}
+		}
+		return filterSuggestions(ss, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func (m *Main) suggestions(cmd *cobra.Command, f plug.CompletionFunc) ([]string, error) {
+	sio := clc.IO{
+		Stdin:  m.stdin,
+		Stderr: m.stderr,
+		// the configuration wizard is not displayed if stdout is not a terminal,
+		// which is the case when the shell reads the suggestions
+		Stdout: m.stdout,
+	}
+	ec, err := NewExecContext(m.lg, sio, m.props, m.mode, m.ms)
+	if err != nil {
+		return nil, err
+	}
+	ec.SetConfigProvider(m.cp)
+	ec.SetMain(m)
+	ec.SetCmd(cmd)
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	return f(ctx, ec)
+}
+
+func (m *Main) cachedSuggestions(sa *store.StoreAccessor, key string) ([]string, bool) {
+	v, err := sa.WithLock(func(s *store.Store) (any, error) {
+		return s.GetEntry([]byte(key))
+	})
+	if err != nil {
+		// the entry either does not exist or expired
+		return nil, false
+	}
+	b := v.([]byte)
+	if len(b) == 0 {
+		return nil, true
+	}
+	return strings.Split(string(b), "\n"), true
+}
+
+func (m *Main) cacheSuggestions(sa *store.StoreAccessor, key string, ss []string) {
+	_, err := sa.WithLock(func(s *store.Store) (any, error) {
+		return nil, s.SetEntry([]byte(key), []byte(strings.Join(ss, "\n")), store.OptionWithTTL(completionCacheTTL))
+	})
+	if err != nil {
+		m.lg.Debugf("Caching the suggestions for %s: %s", key, err.Error())
+	}
+}
+
+// filterSuggestions returns the sorted unique suggestions which start with prefix.
+func filterSuggestions(ss []string, prefix string) []string {
+	seen := make(map[string]struct{}, len(ss))
+	var r []string
+	for _, s := range ss {
+		if _, ok := seen[s]; ok || !strings.HasPrefix(s, prefix) {
+			continue
+		}
+		seen[s] = struct{}{}
+		r = append(r, s)
+	}
+	sort.Strings(r)
+	return r
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/spf13/cobra"
 
 	"github.com/hazelcast/hazelcast-commandline-client/clc"
 	"github.com/hazelcast/hazelcast-commandline-client/clc/metrics"
@@ -25,6 +26,11 @@ func ExtractStartupArgs(args []string) (cfgPath, logFile, logLevel string, err e
 			if ln <= i+1 {
 				return cfgPath, logFile, logLevel, fmt.Errorf("%s requires the configuration name or path", args[i])
 			}
+			// the configuration is being completed in shell completion, so it may not exist yet
+			if i+2 == ln && isCompletionRequest(args) {
+				i++
+				break
+			}
 			cfgPath = args[i+1]
 			i++
 		case fmt.Sprintf("--%s", clc.PropertyLogPath):
@@ -45,6 +51,11 @@ func ExtractStartupArgs(args []string) (cfgPath, logFile, logLevel string, err e
 	return
 }
 
+// isCompletionRequest returns true if the arguments are for the hidden shell completion command.
+func isCompletionRequest(args []string) bool {
+	return len(args) > 0 && (args[0] == cobra.ShellCompRequestCmd || args[0] == cobra.ShellCompNoDescRequestCmd)
+}
+
 func CheckServerCompatible(ci *hazelcast.ClientInternal, targetVersion string) (string, bool) {
 	conn := ci.ConnectionManager().RandomConnection()
 	if conn == nil {
@@ -35,6 +35,14 @@ func TestExtractStartupArgs(t *testing.T) {
 			args:    []string{"-c", "foo.yaml"},
 			cfgPath: "foo.yaml",
 		},
+		{
+			// the configuration is being completed
+			args: []string{"__complete", "map", "--config", "fo"},
+		},
+		{
+			args:    []string{"__complete", "map", "--config", "foo.yaml", "--name", ""},
+			cfgPath: "foo.yaml",
+		},
 		{
 			args:   []string{"--log.path"},
 			hasErr: true,
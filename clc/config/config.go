@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client"
+	pubserialization "github.com/hazelcast/hazelcast-go-client/serialization"
 	"golang.org/x/exp/slices"
 
 	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
@@ -168,7 +169,15 @@ func MakeHzConfig(props plug.ReadOnlyProperties, lg log.Logger) (hazelcast.Confi
 		lg.Debugf("Viridan API Base: %s", apiBase)
 		cfg.Cluster.Cloud.ExperimentalAPIBaseURL = apiBase
 	}
-	cfg.Serialization.SetIdentifiedDataSerializableFactories(serialization.SnapshotFactory{})
+	if err := setDataLayouts(&cfg, props.GetString(clc.PropertySerializationDataLayouts), wd, lg); err != nil {
+		return cfg, err
+	}
+	if err := setCompactSerializers(&cfg, props.GetString(clc.PropertySerializationCompactSchemas), wd, lg); err != nil {
+		return cfg, err
+	}
+	if err := setPortableClassDefinitions(&cfg, props.GetString(clc.PropertySerializationPortableClasses), wd, lg); err != nil {
+		return cfg, err
+	}
 	cfg.Labels = makeClientLabels()
 	cfg.ClientName = makeClientName()
 	usr := props.GetString(clc.PropertyClusterUser)
@@ -182,6 +191,72 @@ func MakeHzConfig(props plug.ReadOnlyProperties, lg log.Logger) (hazelcast.Confi
 	return cfg, nil
 }
 
+func setCompactSerializers(cfg *hazelcast.Config, schemaPaths, wd string, lg log.Logger) error {
+	var schemas []serialization.CompactSchema
+	for _, p := range str.SplitByComma(schemaPaths, true) {
+		p = paths.Join(wd, p)
+		lg.Debugf("Loading Compact schemas from: %s", p)
+		ss, err := serialization.LoadCompactSchemas(p)
+		if err != nil {
+			return err
+		}
+		schemas = append(schemas, ss...)
+	}
+	sers, err := serialization.RegisterCompactSchemas(schemas)
+	if err != nil {
+		return err
+	}
+	if len(sers) > 0 {
+		cfg.Serialization.Compact.SetSerializers(sers...)
+	}
+	return nil
+}
+
+func setPortableClassDefinitions(cfg *hazelcast.Config, classPaths, wd string, lg log.Logger) error {
+	var classes []serialization.PortableClass
+	for _, p := range str.SplitByComma(classPaths, true) {
+		p = paths.Join(wd, p)
+		lg.Debugf("Loading Portable classes from: %s", p)
+		cs, err := serialization.LoadPortableClasses(p)
+		if err != nil {
+			return err
+		}
+		classes = append(classes, cs...)
+	}
+	cds, err := serialization.RegisterPortableClasses(classes)
+	if err != nil {
+		return err
+	}
+	if len(cds) > 0 {
+		cfg.Serialization.SetClassDefinitions(cds...)
+	}
+	return nil
+}
+
+func setDataLayouts(cfg *hazelcast.Config, layoutPaths, wd string, lg log.Logger) error {
+	var layouts []serialization.DataLayout
+	for _, p := range str.SplitByComma(layoutPaths, true) {
+		p = paths.Join(wd, p)
+		lg.Debugf("Loading data layouts from: %s", p)
+		ls, err := serialization.LoadDataLayouts(p)
+		if err != nil {
+			return err
+		}
+		layouts = append(layouts, ls...)
+	}
+	fs, sers, err := serialization.RegisterDataLayouts(layouts)
+	if err != nil {
+		return err
+	}
+	cfg.Serialization.SetIdentifiedDataSerializableFactories(append([]pubserialization.IdentifiedDataSerializableFactory{serialization.SnapshotFactory{}}, fs...)...)
+	for _, s := range sers {
+		if err := cfg.Serialization.SetCustomSerializer(s.Type(), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func makeClientName() string {
 	cn := os.Getenv(envClientName)
 	if cn != "" {
@@ -26,15 +26,23 @@ const (
 	PropertySSLKeyPassword      = "ssl.key-password"
 	PropertySSLSkipVerify       = "ssl.skip-verify"
 	PropertyExperimentalAPIBase = "experimental.api-base"
-	GroupDDSID                  = "dds"
-	GroupDDSTitle               = "Distributed Data Structures"
-	GroupJetID                  = "jet"
-	GroupJetTitle               = "Jet"
-	EnvMaxCols                  = "CLC_MAX_COLS"
-	EnvConfig                   = "CLC_CONFIG"
-	EnvSkipServerVersionCheck   = "CLC_SKIP_SERVER_VERSION_CHECK"
-	EnvYes                      = "CLC_YES"
-	FlagAutoYes                 = "yes"
-	MaxArgs                     = 65535
-	TTLUnset                    = -1
+	// PropertySerializationDataLayouts is the comma separated list of data layout files
+	PropertySerializationDataLayouts = "serialization.data-layouts"
+	// PropertySerializationCompactSchemas is the comma separated list of Compact schema files
+	PropertySerializationCompactSchemas = "serialization.compact-schemas"
+	// PropertySerializationPortableClasses is the comma separated list of Portable class definition files
+	PropertySerializationPortableClasses = "serialization.portable-classes"
+	GroupDDSID                           = "dds"
+	GroupDDSTitle                        = "Distributed Data Structures"
+	GroupJetID                           = "jet"
+	GroupJetTitle                        = "Jet"
+	EnvMaxCols                           = "CLC_MAX_COLS"
+	EnvConfig                            = "CLC_CONFIG"
+	EnvSkipServerVersionCheck            = "CLC_SKIP_SERVER_VERSION_CHECK"
+	EnvYes                               = "CLC_YES"
+	FlagAutoYes                          = "yes"
+	MaxArgs                              = 65535
+	TTLUnset                             = -1
+	// FormatJSON is the PropertyFormat value for the JSON output
+	FormatJSON = "json"
 )
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/sql"
 
@@ -14,11 +15,24 @@ import (
 	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
 )
 
-const CmdPrefix = `\`
+const (
+	CmdPrefix  = `\`
+	CmdBind    = CmdPrefix + "bind"
+	CmdEcho    = CmdPrefix + "echo"
+	CmdExplain = CmdPrefix + "explain"
+	CmdSet     = CmdPrefix + "set"
+	CmdSetQ    = CmdPrefix + "setq"
+	CmdTiming  = CmdPrefix + "timing"
+	CmdUnset   = CmdPrefix + "unset"
+	CmdWatch   = CmdPrefix + "watch"
+)
 
 var ErrHelp = errors.New("interactive help")
 
-func ConvertStatement(ctx context.Context, ec plug.ExecContext, stmt string) (func() error, error) {
+// ConvertStatement converts the given statement to a function which executes it.
+// If timing is true, the elapsed time, the time to first row and the row count are printed after SQL queries.
+// The params are bound to the ? placeholders of SQL queries, they are not used for the shell commands.
+func ConvertStatement(ctx context.Context, ec plug.ExecContext, stmt string, timing bool, params ...any) (func() error, error) {
 	var query string
 	stmt = strings.TrimSpace(stmt)
 	if strings.HasPrefix(stmt, "help") {
@@ -68,18 +82,41 @@ func ConvertStatement(ctx context.Context, ec plug.ExecContext, stmt string) (fu
 			} else {
 				return nil, fmt.Errorf("Usage: %sdm+ [mapping]", CmdPrefix)
 			}
+		case "dv", "dv+", "dt", "dt+", "dc", "dc+", "dj", "dj+", "ds", "ds+", "do", "do+":
+			name := strings.TrimSuffix(parts[0], "+")
+			if len(parts) > 2 {
+				return nil, fmt.Errorf("Usage: %s%s [pattern]", CmdPrefix, parts[0])
+			}
+			var pattern string
+			if len(parts) == 2 {
+				pattern = parts[1]
+			}
+			plus := strings.HasSuffix(parts[0], "+")
+			return func() error {
+				return describe(ctx, ec, describeCommands[name], pattern, plus)
+			}, nil
 		case "exit":
 			return nil, ErrExit
+		case "explain":
+			q := strings.TrimSuffix(strings.TrimSpace(strings.TrimPrefix(stmt, "explain")), ";")
+			if q == "" {
+				return nil, fmt.Errorf("Usage: %sexplain QUERY", CmdPrefix)
+			}
+			return func() error {
+				return explain(ctx, ec, q, params...)
+			}, nil
 		default:
 			return nil, fmt.Errorf("Unknown shell command: %s", stmt)
 		}
+		params = nil
 	} else {
 		query = stmt
 	}
 	f := func() error {
+		start := time.Now()
 		resV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
 			sp.SetText("Executing SQL")
-			res, err := clcsql.ExecSQL(ctx, ec, query)
+			res, err := clcsql.ExecSQL(ctx, ec, query, params...)
 			if err != nil {
 				return nil, err
 			}
@@ -90,23 +127,62 @@ func ConvertStatement(ctx context.Context, ec plug.ExecContext, stmt string) (fu
 		}
 		defer stop()
 		res := resV.(sql.Result)
-		if err := clcsql.UpdateOutput(ctx, ec, res); err != nil {
+		stats, err := clcsql.UpdateOutputWithStats(ctx, ec, res, start)
+		if err != nil {
 			return err
 		}
+		if timing {
+			ec.PrintlnUnnecessary(stats.String())
+		}
 		return nil
 	}
 	return f, nil
 }
 
+func explain(ctx context.Context, ec plug.ExecContext, query string, params ...any) error {
+	planV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		sp.SetText("Retrieving the execution plan")
+		return clcsql.Explain(ctx, ec, query, params...)
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	return clcsql.PrintPlan(ec, planV.(*clcsql.PlanNode))
+}
+
 func InteractiveHelp() string {
 	return `
 Shortcut Commands:
-	\di            List indexes
-	\di   MAPPING  List indexes for a specific mapping
-	\dm            List mappings
-	\dm   MAPPING  Display information about a mapping
-	\dm+  MAPPING  Describe a mapping
-	\exit          Exit the shell
-	\help          Display help for CLC commands
+	\bind     PARAMS   Bind the parameters in the TYPE:VALUE format to the next query
+	\dc[+]    PATTERN  List data connections matching the optional pattern, + displays their DDL
+	\di                List indexes
+	\di       MAPPING  List indexes for a specific mapping
+	\dj[+]    PATTERN  List SQL jobs matching the optional pattern, + displays their queries
+	\dm                List mappings
+	\dm       MAPPING  Display information about a mapping
+	\dm+      MAPPING  Describe a mapping
+	\do[+]    PATTERN  List distributed objects matching the optional pattern, + includes the hidden objects
+	\ds[+]    PATTERN  List snapshots matching the optional pattern, + displays their jobs and times
+	\dt[+]    PATTERN  List types matching the optional pattern, + displays their DDL
+	\dv[+]    PATTERN  List views matching the optional pattern, + displays their DDL
+	\echo     TEXT     Display the text
+	\exit              Exit the shell
+	\explain  QUERY    Display the execution plan of a query
+	\help              Display help for CLC commands
+	\set               List the variables
+	\set      NAME     Set the variable to the value after its name
+	\setq     NAME     Set the variable to the first column of the first row of the query after its name
+	\timing            Toggle displaying the timing and the row count of queries
+	\unset    NAME     Remove the variable
+	\watch             Execute the last query every 2 seconds and redraw its rows
+	\watch    SECONDS  Execute the last query every SECONDS seconds and redraw its rows
+
+Patterns may contain the * and ? wildcards.
+A query can be given to \watch after SECONDS, e.g., \watch 5 SELECT * FROM cities;
+A query which runs longer than SECONDS, even a finite one, is watched as a streaming query: its last 20 rows are redrawn.
+Press Ctrl+C to stop watching.
+Variables are set as \set city 'New York' or \setq total 'SELECT COUNT(*) FROM cities'
+Variables and environment variables are referenced as ${NAME}, use $${ for a literal ${.
 `
 }
@@ -0,0 +1,329 @@
+package shell
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// sqlKeywords are the SQL keywords which are completed.
+var sqlKeywords = []string{
+	"ALL", "ALTER", "AND", "AS", "ASC", "AVG", "BETWEEN", "BIGINT", "BOOLEAN", "BY",
+	"CASE", "CAST", "COUNT", "CREATE", "CROSS", "DATA", "DATE", "DECIMAL", "DELETE", "DESC",
+	"DISTINCT", "DOUBLE", "DROP", "ELSE", "END", "EXISTS", "EXPLAIN", "EXTERNAL", "FALSE", "FROM",
+	"FULL", "GROUP", "HAVING", "IF", "IN", "INDEX", "INNER", "INSERT", "INT", "INTEGER",
+	"INTO", "IS", "JOB", "JOBS", "JOIN", "JSON", "LEFT", "LIKE", "LIMIT", "MAPPING",
+	"MAPPINGS", "MAX", "MIN", "NAME", "NOT", "NULL", "OBJECT", "OFFSET", "ON", "OPTIONS",
+	"OR", "ORDER", "OUTER", "REAL", "REPLACE", "RESTART", "RESUME", "RIGHT", "SELECT", "SET",
+	"SHOW", "SINK", "SMALLINT", "SNAPSHOT", "SUM", "SUSPEND", "TABLE", "THEN", "TIME", "TIMESTAMP",
+	"TINYINT", "TRUE", "TYPE", "UNION", "UPDATE", "USING", "VALUES", "VARCHAR", "VIEW", "VIEWS",
+	"WHEN", "WHERE", "WITH", "ZONE",
+}
+
+// mappingKeywords are the SQL keywords which are followed by a mapping name.
+var mappingKeywords = map[string]struct{}{
+	"FROM":    {},
+	"INTO":    {},
+	"JOIN":    {},
+	"MAPPING": {},
+	"TABLE":   {},
+	"UPDATE":  {},
+}
+
+// mappingShortcuts are the shortcut commands which take a mapping name.
+var mappingShortcuts = map[string]struct{}{
+	CmdPrefix + "di":  {},
+	CmdPrefix + "dm":  {},
+	CmdPrefix + "dm+": {},
+}
+
+// Completer completes SQL keywords, mapping and column names, shortcut commands and CLC commands.
+type Completer struct {
+	root      *cobra.Command
+	mappings  *MappingCache
+	shortcuts []string
+}
+
+// NewCompleter creates a completer.
+// root is the root command of the CLC commands, shortcuts are the shortcut commands, e.g., \dm.
+func NewCompleter(root *cobra.Command, mappings *MappingCache, shortcuts []string) *Completer {
+	return &Completer{
+		root:      root,
+		mappings:  mappings,
+		shortcuts: shortcuts,
+	}
+}
+
+// Do returns the completion candidates for the text before the cursor.
+// It implements the AutoCompleter interface of the readline package.
+func (c *Completer) Do(line []rune, pos int) ([][]rune, int) {
+	word, cs := c.Complete(string(line[:pos]), string(line[pos:]))
+	n := utf8.RuneCountInString(word)
+	res := make([][]rune, 0, len(cs))
+	for _, s := range cs {
+		res = append(res, []rune(s)[n:])
+	}
+	return res, n
+}
+
+// Complete returns the word being completed and the candidates for it.
+// text is the input before the cursor and rest is the input after the cursor.
+// Each candidate starts with the returned word.
+func (c *Completer) Complete(text, rest string) (string, []string) {
+	if strings.HasPrefix(strings.TrimLeftFunc(text, unicode.IsSpace), CmdPrefix) {
+		return c.completeCommand(text, rest)
+	}
+	return c.completeSQL(text, rest)
+}
+
+func (c *Completer) completeCommand(text, rest string) (string, []string) {
+	fs := strings.Fields(text)
+	if len(fs) > 0 && fs[0] == CmdExplain && (len(fs) > 1 || strings.HasSuffix(text, " ")) {
+		// the rest of the command is a query
+		idx := strings.Index(text, CmdExplain) + len(CmdExplain)
+		return c.completeSQL(text[idx:], rest)
+	}
+	var word string
+	if len(fs) > 0 && !strings.HasSuffix(text, " ") {
+		word = fs[len(fs)-1]
+		fs = fs[:len(fs)-1]
+	}
+	if len(fs) == 0 {
+		return word, filterPrefix(c.commandNames(), word)
+	}
+	if _, ok := mappingShortcuts[fs[0]]; ok {
+		if len(fs) == 1 {
+			return word, filterPrefix(c.mappings.Mappings(), word)
+		}
+		return word, nil
+	}
+	cmd := c.findCommand(fs)
+	if cmd == nil {
+		return word, nil
+	}
+	if strings.HasPrefix(word, "-") {
+		return word, filterPrefix(flagNames(cmd), word)
+	}
+	return word, filterPrefix(subcommandNames(cmd), word)
+}
+
+func (c *Completer) commandNames() []string {
+	names := append([]string{}, c.shortcuts...)
+	for _, name := range subcommandNames(c.root) {
+		names = append(names, CmdPrefix+name)
+	}
+	return names
+}
+
+// findCommand returns the CLC command for the given words.
+// Returns nil if the first word is not a CLC command.
+func (c *Completer) findCommand(words []string) *cobra.Command {
+	cmd := c.root
+	for _, w := range words {
+		sub := findSubcommand(cmd, strings.TrimPrefix(w, CmdPrefix))
+		if sub == nil {
+			break
+		}
+		cmd = sub
+	}
+	if cmd == c.root {
+		return nil
+	}
+	return cmd
+}
+
+func (c *Completer) completeSQL(text, rest string) (string, []string) {
+	toks, state := tokenizeSQL(text)
+	if state == sqlStateString || state == sqlStateComment {
+		return "", nil
+	}
+	var word string
+	if n := len(toks); n > 0 && toks[n-1].end == len(text) {
+		last := toks[n-1]
+		if last.quoted && state != sqlStateQuotedIdent {
+			// the quoted identifier is already complete
+			return "", nil
+		}
+		word = last.text
+		toks = toks[:n-1]
+	}
+	var cs []string
+	var names []string
+	if n := len(toks); n > 0 && isMappingKeyword(toks[n-1]) {
+		names = c.mappings.Mappings()
+	} else {
+		names = c.referencedColumns(text + rest)
+		if state != sqlStateQuotedIdent && !strings.HasSuffix(text[:len(text)-len(word)], ".") {
+			cs = keywords(word)
+		}
+	}
+	if state == sqlStateQuotedIdent {
+		// close the quoted identifier
+		quoted := make([]string, len(names))
+		for i, name := range names {
+			quoted[i] = name + `"`
+		}
+		names = quoted
+	}
+	return word, filterPrefix(append(cs, names...), word)
+}
+
+// referencedColumns returns the columns of the mappings referenced in the given statement.
+func (c *Completer) referencedColumns(stmt string) []string {
+	toks, _ := tokenizeSQL(stmt)
+	var cols []string
+	for i := 1; i < len(toks); i++ {
+		if isMappingKeyword(toks[i-1]) {
+			cols = append(cols, c.mappings.Columns(toks[i].text)...)
+		}
+	}
+	return cols
+}
+
+// keywords returns the keywords which start with the given prefix.
+// The keywords are in lowercase if the prefix is in lowercase.
+func keywords(prefix string) []string {
+	lower := prefix != "" && strings.ToLower(prefix) == prefix
+	up := strings.ToUpper(prefix)
+	var kws []string
+	for _, kw := range sqlKeywords {
+		if !strings.HasPrefix(kw, up) {
+			continue
+		}
+		if lower {
+			kw = strings.ToLower(kw)
+		}
+		kws = append(kws, prefix+kw[len(prefix):])
+	}
+	return kws
+}
+
+func isMappingKeyword(tok sqlToken) bool {
+	if tok.quoted {
+		return false
+	}
+	_, ok := mappingKeywords[strings.ToUpper(tok.text)]
+	return ok
+}
+
+func findSubcommand(cmd *cobra.Command, name string) *cobra.Command {
+	for _, sub := range cmd.Commands() {
+		if strings.TrimPrefix(sub.Name(), CmdPrefix) == name {
+			return sub
+		}
+	}
+	return nil
+}
+
+// subcommandNames returns the names of the subcommands without the command prefix.
+func subcommandNames(cmd *cobra.Command) []string {
+	var names []string
+	for _, sub := range cmd.Commands() {
+		name := strings.TrimPrefix(sub.Name(), CmdPrefix)
+		if !sub.IsAvailableCommand() || name == "help" || name == "completion" {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func flagNames(cmd *cobra.Command) []string {
+	var names []string
+	add := func(f *pflag.Flag) {
+		if !f.Hidden {
+			names = append(names, "--"+f.Name)
+		}
+	}
+	cmd.LocalFlags().VisitAll(add)
+	cmd.InheritedFlags().VisitAll(add)
+	return names
+}
+
+// filterPrefix returns the sorted unique items which start with the given prefix.
+func filterPrefix(items []string, prefix string) []string {
+	var res []string
+	seen := map[string]struct{}{}
+	for _, item := range items {
+		if !strings.HasPrefix(item, prefix) {
+			continue
+		}
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		res = append(res, item)
+	}
+	sort.Strings(res)
+	return res
+}
+
+type sqlState int
+
+const (
+	sqlStateNormal sqlState = iota
+	sqlStateString
+	sqlStateQuotedIdent
+	sqlStateComment
+)
+
+type sqlToken struct {
+	text   string
+	quoted bool
+	// end is the offset of the byte after the token
+	end int
+}
+
+// tokenizeSQL returns the identifiers and keywords in the given text.
+// It also returns whether the text ends in a string, a quoted identifier or a comment.
+// The quoted identifiers are returned without the quotes.
+func tokenizeSQL(text string) ([]sqlToken, sqlState) {
+	var toks []sqlToken
+	i := 0
+	for i < len(text) {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case r == '\'':
+			end := strings.IndexByte(text[i+1:], '\'')
+			if end < 0 {
+				return toks, sqlStateString
+			}
+			i += end + 2
+		case r == '"':
+			end := strings.IndexByte(text[i+1:], '"')
+			if end < 0 {
+				toks = append(toks, sqlToken{text: text[i+1:], quoted: true, end: len(text)})
+				return toks, sqlStateQuotedIdent
+			}
+			toks = append(toks, sqlToken{text: text[i+1 : i+1+end], quoted: true, end: i + end + 2})
+			i += end + 2
+		case strings.HasPrefix(text[i:], "--"):
+			end := strings.IndexByte(text[i:], '\n')
+			if end < 0 {
+				return toks, sqlStateComment
+			}
+			i += end + 1
+		case isWordRune(r):
+			start := i
+			for i < len(text) {
+				r, size = utf8.DecodeRuneInString(text[i:])
+				if !isWordRune(r) {
+					break
+				}
+				i += size
+			}
+			toks = append(toks, sqlToken{text: text[start:i], end: i})
+		default:
+			i += size
+		}
+	}
+	return toks, sqlStateNormal
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,90 @@
+package shell_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc/shell"
+)
+
+func TestCompleter_Complete(t *testing.T) {
+	testCases := []struct {
+		name   string
+		text   string
+		rest   string
+		word   string
+		target []string
+	}{
+		{name: "keyword", text: "SEL", word: "SEL", target: []string{"SELECT"}},
+		{name: "lowercase keyword", text: "select * fr", word: "fr", target: []string{"from"}},
+		{name: "mapping after FROM", text: "SELECT * FROM ", target: []string{"cities", "countries"}},
+		{name: "mapping prefix", text: "select * from ci", word: "ci", target: []string{"cities"}},
+		{name: "mapping after JOIN", text: "SELECT * FROM cities JOIN co", word: "co", target: []string{"countries"}},
+		{name: "column", text: "SELECT po", rest: " FROM cities", word: "po", target: []string{"population"}},
+		{name: "column and keyword", text: "SELECT * FROM cities WHERE c", word: "c", target: []string{"case", "cast", "city", "count", "country", "create", "cross"}},
+		{name: "qualified column", text: "SELECT * FROM cities c WHERE c.", target: []string{"__key", "city", "country", "population"}},
+		{name: "quoted mapping", text: `SELECT * FROM "cit`, word: "cit", target: []string{`cities"`}},
+		{name: "in string", text: "SELECT * FROM cities WHERE city = 'Lo", target: nil},
+		{name: "in comment", text: "SELECT * FROM cities -- c", target: nil},
+		{name: "shortcut", text: `\d`, word: `\d`, target: []string{`\di`, `\dm`, `\dm+`}},
+		{name: "command", text: `\ma`, word: `\ma`, target: []string{`\map`}},
+		{name: "shortcut mapping", text: `\dm+ c`, word: "c", target: []string{"cities", "countries"}},
+		{name: "subcommand", text: `\map g`, word: "g", target: []string{"get"}},
+		{name: "flag", text: `\map get --`, word: "--", target: []string{"--format", "--key-type", "--name"}},
+		{name: "explain", text: `\explain SELECT * FROM c`, word: "c", target: []string{"cities", "countries"}},
+		{name: "unknown command", text: `\foo `, target: nil},
+	}
+	c := shell.NewCompleter(testRoot(), testMappings(), []string{`\di`, `\dm`, `\dm+`, `\exit`})
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			word, cs := c.Complete(tc.text, tc.rest)
+			require.Equal(t, tc.word, word)
+			require.Equal(t, tc.target, cs)
+		})
+	}
+}
+
+func TestCompleter_Do(t *testing.T) {
+	c := shell.NewCompleter(testRoot(), testMappings(), nil)
+	cs, n := c.Do([]rune("SELECT * FROM co LIMIT 1"), 16)
+	require.Equal(t, 2, n)
+	require.Equal(t, [][]rune{[]rune("untries")}, cs)
+}
+
+func TestMappingCache_Invalidate(t *testing.T) {
+	var count int
+	mc := shell.NewMappingCache(func(ctx context.Context) (map[string][]string, error) {
+		count++
+		return map[string][]string{"cities": {"city"}}, nil
+	})
+	require.Equal(t, []string{"cities"}, mc.Mappings())
+	require.Equal(t, []string{"city"}, mc.Columns("cities"))
+	require.Equal(t, 1, count)
+	mc.Invalidate()
+	require.Equal(t, []string{"cities"}, mc.Mappings())
+	require.Equal(t, 2, count)
+}
+
+func testMappings() *shell.MappingCache {
+	return shell.NewMappingCache(func(ctx context.Context) (map[string][]string, error) {
+		return map[string][]string{
+			"cities":    {"__key", "country", "city", "population"},
+			"countries": {"__key", "name"},
+		}, nil
+	})
+}
+
+func testRoot() *cobra.Command {
+	root := &cobra.Command{Use: "clc"}
+	root.PersistentFlags().String("format", "", "")
+	m := &cobra.Command{Use: `\map`}
+	get := &cobra.Command{Use: "get", Run: func(*cobra.Command, []string) {}}
+	get.Flags().String("key-type", "", "")
+	get.Flags().String("name", "", "")
+	m.AddCommand(get, &cobra.Command{Use: "put", Run: func(*cobra.Command, []string) {}})
+	root.AddCommand(m)
+	return root
+}
@@ -0,0 +1,180 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/types"
+
+	"github.com/hazelcast/hazelcast-commandline-client/base/objects"
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	clcsql "github.com/hazelcast/hazelcast-commandline-client/clc/sql"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/jet"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec/control"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/str"
+)
+
+// describeFunc returns the output rows for the objects with names matching the pattern.
+// If plus is true, the rows contain more details.
+type describeFunc func(ctx context.Context, ec plug.ExecContext, sp clc.Spinner, pattern string, plus bool) ([]output.Row, error)
+
+// describeCommand is a shell command which describes a kind of objects.
+type describeCommand struct {
+	what string
+	f    describeFunc
+}
+
+var describeCommands = map[string]describeCommand{
+	"dv": {what: "views", f: describeSQLObjects(clcsql.ObjectKindView)},
+	"dt": {what: "types", f: describeSQLObjects(clcsql.ObjectKindType)},
+	"dc": {what: "data connections", f: describeSQLObjects(clcsql.ObjectKindDataConnection)},
+	"dj": {what: "SQL jobs", f: describeJobs},
+	"ds": {what: "snapshots", f: describeSnapshots},
+	"do": {what: "objects", f: describeObjects},
+}
+
+// DescribeCommandNames returns the names of the shell commands which describe objects, including the + variants.
+func DescribeCommandNames() []string {
+	names := make([]string, 0, 2*len(describeCommands))
+	for name := range describeCommands {
+		names = append(names, CmdPrefix+name, CmdPrefix+name+"+")
+	}
+	sort.Strings(names)
+	return names
+}
+
+// describe runs the given describe command and outputs the rows.
+func describe(ctx context.Context, ec plug.ExecContext, dc describeCommand, pattern string, plus bool) error {
+	rows, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) ([]output.Row, error) {
+		sp.SetText(fmt.Sprintf("Getting %s", dc.what))
+		return dc.f(ctx, ec, sp, pattern, plus)
+	})
+	if err != nil {
+		return err
+	}
+	stop()
+	if len(rows) == 0 {
+		ec.PrintlnUnnecessary(fmt.Sprintf("OK No %s found.", dc.what))
+		return nil
+	}
+	return ec.AddOutputRows(ctx, rows...)
+}
+
+// describeSQLObjects returns a describeFunc for the given kind of SQL objects.
+// The plus variant adds the DDL of the objects.
+func describeSQLObjects(kind clcsql.ObjectKind) describeFunc {
+	return func(ctx context.Context, ec plug.ExecContext, sp clc.Spinner, pattern string, plus bool) ([]output.Row, error) {
+		if _, err := cmd.ClientInternal(ctx, ec, sp); err != nil {
+			return nil, err
+		}
+		return clcsql.DescribeObjects(ctx, ec, kind, pattern, plus)
+	}
+}
+
+// describeJobs lists the jobs created by SQL statements, such as CREATE JOB.
+// The light jobs of ordinary queries are not included.
+// The plus variant adds the SQL statement of the jobs.
+func describeJobs(ctx context.Context, ec plug.ExecContext, sp clc.Spinner, pattern string, plus bool) ([]output.Row, error) {
+	ci, err := cmd.ClientInternal(ctx, ec, sp)
+	if err != nil {
+		return nil, err
+	}
+	jl, err := jet.New(ci, sp, ec.Logger()).GetJobList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var rows []output.Row
+	for _, j := range jl {
+		if j.SqlSummary.Query == "" || j.LightJob || !str.MatchWildcard(pattern, j.NameOrId) {
+			continue
+		}
+		row := output.Row{
+			output.Column{Name: "Job ID", Type: serialization.TypeString, Value: jet.IDToString(j.JobId)},
+			output.Column{Name: "Name", Type: serialization.TypeString, Value: j.NameOrId},
+			output.Column{Name: "Status", Type: serialization.TypeString, Value: jobStatus(j)},
+			output.Column{Name: "Submitted", Type: serialization.TypeJavaLocalDateTime, Value: types.LocalDateTime(time.UnixMilli(j.SubmissionTime))},
+			output.Column{Name: "Unbounded", Type: serialization.TypeBool, Value: j.SqlSummary.Unbounded},
+		}
+		if plus {
+			row = append(row, output.Column{Name: "Query", Type: serialization.TypeString, Value: j.SqlSummary.Query})
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func jobStatus(j control.JobAndSqlSummary) string {
+	status := jet.StatusToString(j.Status)
+	if status == "FAILED" && j.UserCancelled {
+		status = "CANCELLED"
+	}
+	return status
+}
+
+// describeSnapshots lists the exported snapshots.
+// The plus variant adds the job name and the creation time of the snapshots.
+func describeSnapshots(ctx context.Context, ec plug.ExecContext, sp clc.Spinner, pattern string, plus bool) ([]output.Row, error) {
+	ci, err := cmd.ClientInternal(ctx, ec, sp)
+	if err != nil {
+		return nil, err
+	}
+	m, err := ci.Client().GetMap(ctx, jet.ExportedSnapshotsMap)
+	if err != nil {
+		return nil, err
+	}
+	es, err := m.GetEntrySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(es, func(i, j int) bool {
+		return fmt.Sprint(es[i].Key) < fmt.Sprint(es[j].Key)
+	})
+	var rows []output.Row
+	for _, e := range es {
+		name, ok := e.Key.(string)
+		if !ok || !str.MatchWildcard(pattern, name) {
+			continue
+		}
+		row := output.Row{
+			output.Column{Name: "Snapshot Name", Type: serialization.TypeString, Value: name},
+		}
+		if sd, ok := e.Value.(*serialization.Snapshot); ok && plus {
+			row = append(row,
+				output.Column{Name: "Job Name", Type: serialization.TypeString, Value: sd.JobName},
+				output.Column{Name: "Time", Type: serialization.TypeJavaLocalDateTime, Value: types.LocalDateTime(time.UnixMilli(sd.CreationTime))},
+			)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// describeObjects lists the distributed objects.
+// The plus variant includes the hidden objects, such as the ones used internally by Hazelcast.
+func describeObjects(ctx context.Context, ec plug.ExecContext, sp clc.Spinner, pattern string, plus bool) ([]output.Row, error) {
+	ci, err := cmd.ClientInternal(ctx, ec, sp)
+	if err != nil {
+		return nil, err
+	}
+	objs, err := ci.Client().GetDistributedObjectsInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var rows []output.Row
+	for _, o := range objects.Filter(objs, "", plus) {
+		if !str.MatchWildcard(pattern, o.Name) {
+			continue
+		}
+		rows = append(rows, output.Row{
+			output.Column{Name: "Service Name", Type: serialization.TypeString, Value: objects.ShortType(o.ServiceName)},
+			output.Column{Name: "Object Name", Type: serialization.TypeString, Value: o.Name},
+		})
+	}
+	return rows, nil
+}
@@ -0,0 +1,75 @@
+package shell
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// mappingLoadTimeout is the maximum time to wait for the mappings while completing.
+const mappingLoadTimeout = 5 * time.Second
+
+// LoadMappingsFn returns the mapping names with the column names of each mapping.
+type LoadMappingsFn func(ctx context.Context) (map[string][]string, error)
+
+// MappingCache caches the mapping and column names used for completion.
+// The mappings are loaded when they are first required, and reloaded after the cache is invalidated.
+type MappingCache struct {
+	mu       sync.Mutex
+	load     LoadMappingsFn
+	mappings map[string][]string
+	names    []string
+}
+
+func NewMappingCache(load LoadMappingsFn) *MappingCache {
+	return &MappingCache{load: load}
+}
+
+// Mappings returns the sorted mapping names.
+func (mc *MappingCache) Mappings() []string {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	mc.ensureLoaded()
+	return mc.names
+}
+
+// Columns returns the column names of the given mapping.
+func (mc *MappingCache) Columns(mapping string) []string {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	mc.ensureLoaded()
+	return mc.mappings[mapping]
+}
+
+// Invalidate clears the cache, so the mappings are loaded again when they are required.
+// It should be called after the mappings are changed, e.g., after a DDL statement.
+func (mc *MappingCache) Invalidate() {
+	mc.mu.Lock()
+	mc.mappings = nil
+	mc.names = nil
+	mc.mu.Unlock()
+}
+
+func (mc *MappingCache) ensureLoaded() {
+	if mc.mappings != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), mappingLoadTimeout)
+	defer cancel()
+	ms, err := mc.load(ctx)
+	if err != nil {
+		// not caching the failure, so loading is retried the next time
+		return
+	}
+	if ms == nil {
+		ms = map[string][]string{}
+	}
+	names := make([]string, 0, len(ms))
+	for name := range ms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	mc.mappings = ms
+	mc.names = names
+}
@@ -9,6 +9,7 @@ import (
 	"os/signal"
 	"runtime"
 	"strings"
+	"unicode"
 
 	"github.com/fatih/color"
 	"github.com/gohxs/readline"
@@ -84,6 +85,14 @@ func (sh *Shell) SetCommentPrefix(pfx string) {
 	sh.commentPrefix = pfx
 }
 
+// SetCompleter sets the completer used when the Tab key is pressed.
+// Completion is supported only by the default line reader.
+func (sh *Shell) SetCompleter(c *Completer) {
+	if lr, ok := sh.lr.(*GohxsLineReader); ok {
+		lr.rl.Config.AutoComplete = c
+	}
+}
+
 func (sh *Shell) Start(ctx context.Context) error {
 	for {
 		text, err := sh.readTextReadline(ctx)
@@ -148,16 +157,66 @@ func (sh *Shell) readTextReadline(ctx context.Context) (string, error) {
 	return text, nil
 }
 
+// SQLColoring highlights the input line of the ny line reader.
+// The shell commands, SQL strings, quoted identifiers, numbers and comments are highlighted.
+// SQL keywords are not highlighted, since the colors are assigned one rune at a time.
 type SQLColoring struct {
-	text []rune
+	state  sqlState
+	prev   rune
+	start  bool
+	cmd    bool
+	inWord bool
 }
 
 func (c *SQLColoring) Init() int {
-	c.text = nil
+	*c = SQLColoring{start: true}
 	return ny.DefaultForeGroundColor
 }
 
-func (c *SQLColoring) Next(_ rune) int {
+func (c *SQLColoring) Next(r rune) int {
+	prev := c.prev
+	c.prev = r
+	if c.start {
+		if unicode.IsSpace(r) {
+			return ny.DefaultForeGroundColor
+		}
+		c.start = false
+		c.cmd = r == '\\'
+	}
+	if c.cmd {
+		return ny.Cyan
+	}
+	switch c.state {
+	case sqlStateString:
+		if r == '\'' {
+			c.state = sqlStateNormal
+		}
+		return ny.Magenta
+	case sqlStateQuotedIdent:
+		if r == '"' {
+			c.state = sqlStateNormal
+		}
+		return ny.DarkYellow
+	case sqlStateComment:
+		return ny.DarkGray
+	}
+	wasInWord := c.inWord
+	c.inWord = isWordRune(r)
+	switch {
+	case r == '\'':
+		c.state = sqlStateString
+		return ny.Magenta
+	case r == '"':
+		c.state = sqlStateQuotedIdent
+		return ny.DarkYellow
+	case r == '-' && prev == '-':
+		c.state = sqlStateComment
+		return ny.DarkGray
+	case unicode.IsDigit(r) && !wasInWord:
+		// the rest of the number is colored as a word
+		c.inWord = false
+		return ny.Blue
+	}
 	return ny.DefaultForeGroundColor
 }
 
@@ -0,0 +1,89 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	variableNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// variableRefRe matches ${NAME} and the escaped $${NAME}
+	variableRefRe = regexp.MustCompile(`\$?\$\{([^}]*)}`)
+)
+
+// Variables are the session variables of the shell and scripts.
+// The variables which are not set are looked up in the environment.
+type Variables struct {
+	vars map[string]string
+}
+
+func NewVariables() *Variables {
+	return &Variables{vars: map[string]string{}}
+}
+
+// Set sets the value of the variable with the given name.
+func (v *Variables) Set(name, value string) error {
+	if !variableNameRe.MatchString(name) {
+		return fmt.Errorf("invalid variable name: %s", name)
+	}
+	v.vars[name] = value
+	return nil
+}
+
+// Unset removes the variable with the given name.
+func (v *Variables) Unset(name string) {
+	delete(v.vars, name)
+}
+
+// Get returns the value of the variable with the given name, or the environment variable with that name.
+func (v *Variables) Get(name string) (string, bool) {
+	if value, ok := v.vars[name]; ok {
+		return value, true
+	}
+	return os.LookupEnv(name)
+}
+
+// Names returns the sorted names of the session variables.
+// The environment variables are not included.
+func (v *Variables) Names() []string {
+	names := make([]string, 0, len(v.vars))
+	for name := range v.vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Expand replaces the ${NAME} references in the text with the values of the variables.
+// $${ is replaced with a literal ${, so $${NAME} is replaced with ${NAME}.
+// Returns an error if a referenced variable is not defined.
+func (v *Variables) Expand(text string) (string, error) {
+	var err error
+	r := variableRefRe.ReplaceAllStringFunc(text, func(ref string) string {
+		if strings.HasPrefix(ref, "$$") {
+			return ref[1:]
+		}
+		name := variableRefRe.FindStringSubmatch(ref)[1]
+		value, ok := v.Get(name)
+		if !ok && err == nil {
+			err = fmt.Errorf("undefined variable: %s", name)
+		}
+		return value
+	})
+	if err != nil {
+		return "", err
+	}
+	return r, nil
+}
+
+// ParseVariable parses a variable in the NAME=VALUE format.
+func ParseVariable(s string) (name, value string, err error) {
+	name, value, ok := strings.Cut(s, "=")
+	if !ok || !variableNameRe.MatchString(name) {
+		return "", "", fmt.Errorf("invalid variable: %s, expected NAME=VALUE", s)
+	}
+	return name, value, nil
+}
@@ -0,0 +1,66 @@
+package shell_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc/shell"
+)
+
+func TestVariables_Expand(t *testing.T) {
+	t.Setenv("CLC_TEST_ENV", "staging")
+	vars := shell.NewVariables()
+	require.NoError(t, vars.Set("city", "London"))
+	require.NoError(t, vars.Set("CLC_TEST_ENV", "production"))
+	testCases := []struct {
+		name   string
+		text   string
+		target string
+		err    string
+	}{
+		{name: "no variables", text: "SELECT 1", target: "SELECT 1"},
+		{name: "variable", text: "SELECT * FROM cities WHERE name = '${city}'", target: "SELECT * FROM cities WHERE name = 'London'"},
+		{name: "repeated variable", text: `\echo ${city} ${city}`, target: `\echo London London`},
+		{name: "session variable overrides environment", text: "${CLC_TEST_ENV}", target: "production"},
+		{name: "not a reference", text: "$city {city} $", target: "$city {city} $"},
+		{name: "escaped reference", text: "SELECT '$${city}'", target: "SELECT '${city}'"},
+		{name: "escaped reference of undefined variable", text: "$${country} ${city}", target: "${country} London"},
+		{name: "dollar before reference", text: "$$$${city}", target: "$$${city}"},
+		{name: "undefined variable", text: "${country}", err: "undefined variable: country"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := vars.Expand(tc.text)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.target, r)
+		})
+	}
+	vars.Unset("CLC_TEST_ENV")
+	r, err := vars.Expand("${CLC_TEST_ENV}")
+	require.NoError(t, err)
+	require.Equal(t, "staging", r, "the environment variable should be used")
+	require.Equal(t, []string{"city"}, vars.Names())
+}
+
+func TestVariables_Set(t *testing.T) {
+	vars := shell.NewVariables()
+	require.NoError(t, vars.Set("_name1", ""))
+	require.EqualError(t, vars.Set("1name", "x"), "invalid variable name: 1name")
+	require.EqualError(t, vars.Set("my-name", "x"), "invalid variable name: my-name")
+}
+
+func TestParseVariable(t *testing.T) {
+	name, value, err := shell.ParseVariable("env=staging=1")
+	require.NoError(t, err)
+	require.Equal(t, "env", name)
+	require.Equal(t, "staging=1", value)
+	_, _, err = shell.ParseVariable("env")
+	require.EqualError(t, err, "invalid variable: env, expected NAME=VALUE")
+	_, _, err = shell.ParseVariable("=staging")
+	require.EqualError(t, err, "invalid variable: =staging, expected NAME=VALUE")
+}
@@ -0,0 +1,86 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/sql"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	hzerrors "github.com/hazelcast/hazelcast-commandline-client/errors"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/str"
+)
+
+// summaryStatementLength is the maximum length of a statement displayed in the summary.
+const summaryStatementLength = 60
+
+// StatementResult is the result of a statement executed by ExecStatements.
+type StatementResult struct {
+	Statement string
+	Stats     QueryStats
+	Err       error
+}
+
+// ExecStatements executes the given statements in order, and writes the output of each statement.
+// If stopOnError is true, the statements after the first failing statement are not executed,
+// otherwise the errors are written to stderr and the execution continues with the next statement.
+// Returns the results of the executed statements.
+func ExecStatements(ctx context.Context, ec plug.ExecContext, stmts []string, stopOnError bool) []StatementResult {
+	results := make([]StatementResult, 0, len(stmts))
+	for i, stmt := range stmts {
+		stats, err := execStatement(ctx, ec, stmt, i, len(stmts))
+		results = append(results, StatementResult{Statement: stmt, Stats: stats, Err: err})
+		if err == nil {
+			continue
+		}
+		if stopOnError || ctx.Err() != nil {
+			break
+		}
+		err = fmt.Errorf("statement %d: %w", i+1, err)
+		check.I2(fmt.Fprintln(ec.Stderr(), str.Colorize(hzerrors.MakeString(err))))
+	}
+	return results
+}
+
+func execStatement(ctx context.Context, ec plug.ExecContext, stmt string, index, count int) (QueryStats, error) {
+	start := time.Now()
+	resV, stop, err := ec.ExecuteBlocking(ctx, func(ctx context.Context, sp clc.Spinner) (any, error) {
+		sp.SetText(fmt.Sprintf("Executing statement %d of %d", index+1, count))
+		return ExecSQL(ctx, ec, stmt)
+	})
+	if err != nil {
+		return QueryStats{Elapsed: time.Since(start)}, err
+	}
+	defer stop()
+	return UpdateOutputWithStats(ctx, ec, resV.(sql.Result), start)
+}
+
+// StatementSummaryRows returns the output rows which summarize the given statement results.
+func StatementSummaryRows(results []StatementResult) []output.Row {
+	rows := make([]output.Row, len(results))
+	for i, r := range results {
+		status := "OK"
+		if r.Err != nil {
+			status = "ERROR"
+		}
+		rows[i] = output.Row{
+			output.Column{Name: "#", Type: serialization.TypeInt32, Value: int32(i + 1)},
+			output.Column{Name: "Statement", Type: serialization.TypeString, Value: summaryStatement(r.Statement)},
+			output.Column{Name: "Status", Type: serialization.TypeString, Value: status},
+			output.Column{Name: "Duration", Type: serialization.TypeString, Value: r.Stats.Elapsed.Round(time.Millisecond).String()},
+			output.Column{Name: "Rows", Type: serialization.TypeInt64, Value: r.Stats.Rows},
+		}
+	}
+	return rows
+}
+
+// summaryStatement returns the statement in a single line, shortened if necessary.
+func summaryStatement(stmt string) string {
+	return str.MaybeShorten(strings.Join(strings.Fields(stmt), " "), summaryStatementLength)
+}
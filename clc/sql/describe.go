@@ -0,0 +1,92 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/str"
+)
+
+// ObjectKind is a kind of SQL object which can be listed with a SHOW statement and described with GET_DDL.
+type ObjectKind struct {
+	// Show is the statement which lists the objects, the first column of its rows is the object name.
+	Show string
+	// DDLNamespace is the namespace argument of the GET_DDL function for the objects.
+	DDLNamespace string
+}
+
+var (
+	ObjectKindView           = ObjectKind{Show: "SHOW VIEWS", DDLNamespace: "relation"}
+	ObjectKindType           = ObjectKind{Show: "SHOW TYPES", DDLNamespace: "type"}
+	ObjectKindDataConnection = ObjectKind{Show: "SHOW DATA CONNECTIONS", DDLNamespace: "dataconnection"}
+)
+
+// DescribeObjects returns the output rows for the objects of the given kind with names matching the pattern.
+// See str.MatchWildcard for the pattern syntax.
+// If ddl is true, the DDL of each object is added to its row.
+func DescribeObjects(ctx context.Context, ec plug.ExecContext, kind ObjectKind, pattern string, ddl bool) ([]output.Row, error) {
+	res, err := execSQL(ctx, ec, kind.Show)
+	if err != nil {
+		return nil, adaptSQLError(err)
+	}
+	defer res.Close()
+	it, err := res.Iterator()
+	if err != nil {
+		return nil, err
+	}
+	var rows []output.Row
+	for it.HasNext() {
+		row, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		name := rowStrings(row)[0]
+		if !str.MatchWildcard(pattern, name) {
+			continue
+		}
+		orow, err := OutputRow(row)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, orow)
+	}
+	if !ddl {
+		return rows, nil
+	}
+	for i, row := range rows {
+		name := row[0].Value.(string)
+		ddl, err := objectDDL(ctx, ec, kind.DDLNamespace, name)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the DDL of %s: %w", name, err)
+		}
+		rows[i] = append(row, output.Column{
+			Name:  "ddl",
+			Type:  serialization.TypeString,
+			Value: ddl,
+		})
+	}
+	return rows, nil
+}
+
+func objectDDL(ctx context.Context, ec plug.ExecContext, namespace, name string) (string, error) {
+	res, err := execSQL(ctx, ec, "SELECT GET_DDL(?, ?)", namespace, name)
+	if err != nil {
+		return "", adaptSQLError(err)
+	}
+	defer res.Close()
+	it, err := res.Iterator()
+	if err != nil {
+		return "", err
+	}
+	if !it.HasNext() {
+		return "", nil
+	}
+	row, err := it.Next()
+	if err != nil {
+		return "", err
+	}
+	return rowStrings(row)[0], nil
+}
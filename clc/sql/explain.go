@@ -0,0 +1,240 @@
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+const (
+	planIndent       = "  "
+	planAttrIndex    = "index"
+	planRowCountText = "rowcount = "
+)
+
+// PlanNode is a node of the execution plan returned by EXPLAIN.
+type PlanNode struct {
+	Operator   string          `json:"operator"`
+	Attributes []PlanAttribute `json:"attributes,omitempty"`
+	// RowCount is the estimated row count, it is nil if the plan doesn't include it.
+	RowCount *float64 `json:"rowCount,omitempty"`
+	// Index is the name of the index used by the operator, if any.
+	Index    string      `json:"index,omitempty"`
+	Children []*PlanNode `json:"children,omitempty"`
+}
+
+type PlanAttribute struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Explain runs EXPLAIN for the given query and returns the execution plan.
+func Explain(ctx context.Context, ec plug.ExecContext, query string, params ...any) (*PlanNode, error) {
+	res, err := ExecSQL(ctx, ec, "EXPLAIN "+query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	it, err := res.Iterator()
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	for it.HasNext() {
+		row, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, rowStrings(row)[0])
+	}
+	return ParsePlan(lines)
+}
+
+// ParsePlan parses the rows returned by EXPLAIN.
+// Each line of the rows is an operator, the children of an operator are indented more than the operator.
+func ParsePlan(rows []string) (*PlanNode, error) {
+	var root *PlanNode
+	// stack contains the latest node at each level
+	var stack []*PlanNode
+	for _, row := range rows {
+		for _, line := range strings.Split(row, "\n") {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			text := strings.TrimLeft(line, " ")
+			level := (len(line) - len(text)) / len(planIndent)
+			node, err := parsePlanNode(text)
+			if err != nil {
+				return nil, err
+			}
+			if root == nil {
+				if level != 0 {
+					return nil, fmt.Errorf("parsing plan: unexpected indentation: %s", line)
+				}
+				root = node
+				stack = []*PlanNode{node}
+				continue
+			}
+			if level == 0 || level > len(stack) {
+				return nil, fmt.Errorf("parsing plan: unexpected indentation: %s", line)
+			}
+			parent := stack[level-1]
+			parent.Children = append(parent.Children, node)
+			stack = append(stack[:level], node)
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("parsing plan: the plan is empty")
+	}
+	return root, nil
+}
+
+// parsePlanNode parses an operator line, e.g.:
+// IndexScanMapPhysicalRel(table=[[hazelcast, public, cities]], index=[cities_idx]): rowcount = 100.0, cumulative cost = {...}
+func parsePlanNode(text string) (*PlanNode, error) {
+	node := &PlanNode{}
+	open := strings.IndexByte(text, '(')
+	if open < 0 {
+		node.Operator = splitRowCount(node, text)
+		return node, nil
+	}
+	node.Operator = text[:open]
+	closing := matchingParen(text, open)
+	if closing < 0 {
+		return nil, fmt.Errorf("parsing plan: unbalanced parentheses: %s", text)
+	}
+	for _, attr := range splitTopLevel(text[open+1:closing], ',') {
+		attr = strings.TrimSpace(attr)
+		if attr == "" {
+			continue
+		}
+		name, value, _ := strings.Cut(attr, "=")
+		pa := PlanAttribute{Name: name, Value: value}
+		if name == planAttrIndex {
+			node.Index = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+		}
+		node.Attributes = append(node.Attributes, pa)
+	}
+	splitRowCount(node, text[closing+1:])
+	return node, nil
+}
+
+// splitRowCount sets the row count of the node if the text contains it.
+// Returns the text before the row count.
+func splitRowCount(node *PlanNode, text string) string {
+	before, after, ok := strings.Cut(text, ":")
+	if !ok {
+		return strings.TrimSpace(text)
+	}
+	after = strings.TrimSpace(after)
+	if strings.HasPrefix(after, planRowCountText) {
+		s := strings.TrimPrefix(after, planRowCountText)
+		if idx := strings.IndexByte(s, ','); idx >= 0 {
+			s = s[:idx]
+		}
+		if v, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+			node.RowCount = &v
+		}
+	}
+	return strings.TrimSpace(before)
+}
+
+func matchingParen(text string, open int) int {
+	depth := 0
+	for i := open; i < len(text); i++ {
+		switch text[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitTopLevel splits the text by the separator, skipping the separators in brackets.
+func splitTopLevel(text string, sep byte) []string {
+	var parts []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case sep:
+			if depth == 0 {
+				parts = append(parts, text[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, text[start:])
+}
+
+// PrintPlan prints the plan as an indented tree, or as JSON if the output format is JSON.
+func PrintPlan(ec plug.ExecContext, plan *PlanNode) error {
+	if ec.Props().GetString(clc.PropertyFormat) == clc.FormatJSON {
+		b, err := json.Marshal(plan)
+		if err != nil {
+			return err
+		}
+		check.I2(fmt.Fprintln(ec.Stdout(), string(b)))
+		return nil
+	}
+	WritePlanTree(ec.Stdout(), plan)
+	return nil
+}
+
+var (
+	planOperatorColor = color.New(color.Bold)
+	planIndexColor    = color.New(color.FgGreen, color.Bold)
+	planRowCountColor = color.New(color.FgCyan)
+)
+
+// WritePlanTree writes the plan as an indented tree.
+// The operators, the indexes and the estimated row counts are highlighted.
+func WritePlanTree(w io.Writer, plan *PlanNode) {
+	writePlanNode(w, plan, "", "")
+}
+
+func writePlanNode(w io.Writer, node *PlanNode, prefix, childPrefix string) {
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	sb.WriteString(planOperatorColor.Sprint(node.Operator))
+	for _, attr := range node.Attributes {
+		sb.WriteString("  ")
+		if attr.Name == planAttrIndex {
+			sb.WriteString(planIndexColor.Sprintf("%s=%s", attr.Name, attr.Value))
+			continue
+		}
+		sb.WriteString(attr.Name)
+		sb.WriteByte('=')
+		sb.WriteString(attr.Value)
+	}
+	if node.RowCount != nil {
+		sb.WriteString("  ")
+		sb.WriteString(planRowCountColor.Sprintf("rows=%s", strconv.FormatFloat(*node.RowCount, 'f', -1, 64)))
+	}
+	check.I2(fmt.Fprintln(w, sb.String()))
+	for i, child := range node.Children {
+		if i == len(node.Children)-1 {
+			writePlanNode(w, child, childPrefix+"└── ", childPrefix+"    ")
+			continue
+		}
+		writePlanNode(w, child, childPrefix+"├── ", childPrefix+"│   ")
+	}
+}
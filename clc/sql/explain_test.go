@@ -0,0 +1,82 @@
+package sql_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+
+	clcsql "github.com/hazelcast/hazelcast-commandline-client/clc/sql"
+)
+
+var testPlanRows = []string{
+	"ProjectPhysicalRel(name=[$1], city=[$3])",
+	"  HashJoinPhysicalRel(condition=[=($0, $2)], joinType=[inner]): rowcount = 150.0, cumulative cost = {300 rows}",
+	"    FullScanPhysicalRel(table=[[hazelcast, public, people[projects=[$0, $1]]]], discriminator=[0]): rowcount = 100.0",
+	"    IndexScanMapPhysicalRel(table=[[hazelcast, public, cities]], index=[cities_idx], indexExp=[>($0, 10)])",
+}
+
+func TestParsePlan(t *testing.T) {
+	plan, err := clcsql.ParsePlan(testPlanRows)
+	require.NoError(t, err)
+	require.Equal(t, "ProjectPhysicalRel", plan.Operator)
+	require.Equal(t, []clcsql.PlanAttribute{{Name: "name", Value: "[$1]"}, {Name: "city", Value: "[$3]"}}, plan.Attributes)
+	require.Nil(t, plan.RowCount)
+	require.Len(t, plan.Children, 1)
+	join := plan.Children[0]
+	require.Equal(t, "HashJoinPhysicalRel", join.Operator)
+	require.Equal(t, 150.0, *join.RowCount)
+	require.Len(t, join.Children, 2)
+	scan := join.Children[0]
+	require.Equal(t, "FullScanPhysicalRel", scan.Operator)
+	require.Equal(t, []clcsql.PlanAttribute{
+		{Name: "table", Value: "[[hazelcast, public, people[projects=[$0, $1]]]]"},
+		{Name: "discriminator", Value: "[0]"},
+	}, scan.Attributes)
+	require.Equal(t, 100.0, *scan.RowCount)
+	require.Equal(t, "", scan.Index)
+	require.Equal(t, "cities_idx", join.Children[1].Index)
+}
+
+func TestParsePlan_SingleRow(t *testing.T) {
+	plan, err := clcsql.ParsePlan([]string{"ProjectPhysicalRel(name=[$1])\n  FullScanPhysicalRel(table=[[hazelcast, public, people]])\n"})
+	require.NoError(t, err)
+	require.Len(t, plan.Children, 1)
+	require.Equal(t, "FullScanPhysicalRel", plan.Children[0].Operator)
+}
+
+func TestParsePlan_Error(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rows    []string
+		errText string
+	}{
+		{name: "empty", rows: nil, errText: "parsing plan: the plan is empty"},
+		{name: "indented root", rows: []string{"  FullScanPhysicalRel()"}, errText: "parsing plan: unexpected indentation:   FullScanPhysicalRel()"},
+		{name: "skipped level", rows: []string{"A()", "    B()"}, errText: "parsing plan: unexpected indentation:     B()"},
+		{name: "unbalanced", rows: []string{"A(name=[$1]"}, errText: "parsing plan: unbalanced parentheses: A(name=[$1]"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := clcsql.ParsePlan(tc.rows)
+			require.EqualError(t, err, tc.errText)
+		})
+	}
+}
+
+func TestWritePlanTree(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = noColor }()
+	plan, err := clcsql.ParsePlan(testPlanRows)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	clcsql.WritePlanTree(&buf, plan)
+	target := `ProjectPhysicalRel  name=[$1]  city=[$3]
+└── HashJoinPhysicalRel  condition=[=($0, $2)]  joinType=[inner]  rows=150
+    ├── FullScanPhysicalRel  table=[[hazelcast, public, people[projects=[$0, $1]]]]  discriminator=[0]  rows=100
+    └── IndexScanMapPhysicalRel  table=[[hazelcast, public, cities]]  index=[cities_idx]  indexExp=[>($0, 10)]
+`
+	require.Equal(t, target, buf.String())
+}
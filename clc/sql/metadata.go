@@ -0,0 +1,109 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/sql"
+
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+var ddlKeywords = map[string]struct{}{
+	"ALTER":  {},
+	"CREATE": {},
+	"DROP":   {},
+}
+
+// queryKeywords are the first keywords of the statements which return rows.
+var queryKeywords = map[string]struct{}{
+	"SELECT": {},
+	"SHOW":   {},
+	"VALUES": {},
+	"WITH":   {},
+}
+
+// Mappings returns the mapping names with the column names of each mapping.
+// The columns are in the order they were defined.
+func Mappings(ctx context.Context, ec plug.ExecContext) (map[string][]string, error) {
+	ms := map[string][]string{}
+	rows, err := queryStrings(ctx, ec, "SELECT table_name FROM information_schema.mappings")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving mappings: %w", err)
+	}
+	for _, row := range rows {
+		ms[row[0]] = nil
+	}
+	rows, err = queryStrings(ctx, ec, "SELECT table_name, column_name FROM information_schema.columns ORDER BY table_name, ordinal_position")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving columns: %w", err)
+	}
+	for _, row := range rows {
+		// the columns of views are skipped
+		cols, ok := ms[row[0]]
+		if !ok {
+			continue
+		}
+		ms[row[0]] = append(cols, row[1])
+	}
+	return ms, nil
+}
+
+// IsDDL returns true if the given statement is a DDL statement, such as CREATE MAPPING.
+func IsDDL(stmt string) bool {
+	fs := strings.Fields(stmt)
+	if len(fs) == 0 {
+		return false
+	}
+	_, ok := ddlKeywords[strings.ToUpper(fs[0])]
+	return ok
+}
+
+// IsQuery returns true if the given statement returns rows, such as SELECT.
+func IsQuery(stmt string) bool {
+	fs := strings.Fields(strings.TrimLeft(strings.TrimSpace(stmt), "("))
+	if len(fs) == 0 {
+		return false
+	}
+	_, ok := queryKeywords[strings.ToUpper(fs[0])]
+	return ok
+}
+
+// queryStrings runs the given query and returns the rows.
+// All columns of the query must be strings.
+func queryStrings(ctx context.Context, ec plug.ExecContext, query string) ([][]string, error) {
+	res, err := execSQL(ctx, ec, query)
+	if err != nil {
+		return nil, adaptSQLError(err)
+	}
+	defer res.Close()
+	it, err := res.Iterator()
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]string
+	for it.HasNext() {
+		row, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, rowStrings(row))
+	}
+	return rows, nil
+}
+
+func rowStrings(row sql.Row) []string {
+	n := row.Metadata().ColumnCount()
+	ss := make([]string, n)
+	for i := 0; i < n; i++ {
+		v, err := row.Get(i)
+		if err != nil {
+			continue
+		}
+		if s, ok := v.(string); ok {
+			ss[i] = s
+		}
+	}
+	return ss
+}
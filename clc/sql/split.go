@@ -0,0 +1,93 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// SplitStatements splits the given text into the SQL statements separated by semicolons.
+// The semicolons in strings, quoted identifiers and comments are not separators.
+// The comments before a statement are removed, and the statements which contain only comments are skipped.
+func SplitStatements(text string) ([]string, error) {
+	var stmts []string
+	var sb strings.Builder
+	// start is the offset of the first character of the statement which is not a comment or whitespace
+	start := -1
+	flush := func() {
+		if start >= 0 {
+			stmts = append(stmts, strings.TrimSpace(sb.String()[start:]))
+		}
+		sb.Reset()
+		start = -1
+	}
+	markCode := func() {
+		if start < 0 {
+			start = sb.Len()
+		}
+	}
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\'' || c == '"':
+			end := closingQuote(text, i)
+			if end < 0 {
+				what := "string"
+				if c == '"' {
+					what = "quoted identifier"
+				}
+				return nil, fmt.Errorf("unterminated %s at line %d", what, lineOf(text, i))
+			}
+			markCode()
+			sb.WriteString(text[i : end+1])
+			i = end + 1
+		case strings.HasPrefix(text[i:], "--"):
+			end := strings.IndexByte(text[i:], '\n')
+			if end < 0 {
+				end = len(text) - i
+			}
+			sb.WriteString(text[i : i+end])
+			i += end
+		case strings.HasPrefix(text[i:], "/*"):
+			end := strings.Index(text[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment at line %d", lineOf(text, i))
+			}
+			sb.WriteString(text[i : i+end+4])
+			i += end + 4
+		case c == ';':
+			flush()
+			i++
+		default:
+			if !unicode.IsSpace(rune(c)) {
+				markCode()
+			}
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+	return stmts, nil
+}
+
+// closingQuote returns the offset of the quote which closes the quote at the given offset.
+// Two consecutive quotes are an escaped quote.
+// Returns -1 if the quote is not closed.
+func closingQuote(text string, open int) int {
+	q := text[open]
+	for i := open + 1; i < len(text); i++ {
+		if text[i] != q {
+			continue
+		}
+		if i+1 < len(text) && text[i+1] == q {
+			i++
+			continue
+		}
+		return i
+	}
+	return -1
+}
+
+func lineOf(text string, offset int) int {
+	return strings.Count(text[:offset], "\n") + 1
+}
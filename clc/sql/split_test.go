@@ -0,0 +1,51 @@
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clcsql "github.com/hazelcast/hazelcast-commandline-client/clc/sql"
+)
+
+func TestSplitStatements(t *testing.T) {
+	testCases := []struct {
+		name   string
+		text   string
+		target []string
+	}{
+		{name: "empty", text: "", target: nil},
+		{name: "single", text: "SELECT 1", target: []string{"SELECT 1"}},
+		{name: "multiple", text: "SELECT 1;\nSELECT 2;\n", target: []string{"SELECT 1", "SELECT 2"}},
+		{name: "quoted semicolon", text: `SELECT 'a;b' FROM "x;y"; SELECT 2`, target: []string{`SELECT 'a;b' FROM "x;y"`, "SELECT 2"}},
+		{name: "escaped quote", text: `SELECT 'it''s;'; SELECT "a"";"`, target: []string{`SELECT 'it''s;'`, `SELECT "a"";"`}},
+		{name: "multiline string", text: "INSERT INTO t VALUES ('a\n;b');", target: []string{"INSERT INTO t VALUES ('a\n;b')"}},
+		{name: "line comment", text: "-- first; statement\nSELECT 1; -- trailing; comment\n", target: []string{"SELECT 1"}},
+		{name: "block comment", text: "/* a; b */ SELECT /* c; */ 1;", target: []string{"SELECT /* c; */ 1"}},
+		{name: "only comments", text: "-- nothing\n/* here */;;", target: nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stmts, err := clcsql.SplitStatements(tc.text)
+			require.NoError(t, err)
+			require.Equal(t, tc.target, stmts)
+		})
+	}
+}
+
+func TestSplitStatements_Error(t *testing.T) {
+	testCases := []struct {
+		text    string
+		errText string
+	}{
+		{text: "SELECT 1;\nSELECT 'a", errText: "unterminated string at line 2"},
+		{text: `SELECT "a`, errText: "unterminated quoted identifier at line 1"},
+		{text: "SELECT 1; /* a", errText: "unterminated comment at line 1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.errText, func(t *testing.T) {
+			_, err := clcsql.SplitStatements(tc.text)
+			require.EqualError(t, err, tc.errText)
+		})
+	}
+}
@@ -4,25 +4,35 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"os/signal"
+	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/sql"
 
+	"github.com/hazelcast/hazelcast-commandline-client/internal"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
 	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
 )
 
-const PropertyUseMappingSuggestion = "use-mapping-suggestion"
+const (
+	PropertyUseMappingSuggestion = "use-mapping-suggestion"
+	PropertyCursorBufferSize     = "cursor-buffer-size"
+	PropertySchema               = "schema"
+	paramNull                    = "null"
+)
 
-func ExecSQL(ctx context.Context, ec plug.ExecContext, query string) (sql.Result, error) {
+// ExecSQL executes the given query.
+// The params are bound to the ? placeholders in the query.
+func ExecSQL(ctx context.Context, ec plug.ExecContext, query string, params ...any) (sql.Result, error) {
 	as := ec.Props().GetBool(PropertyUseMappingSuggestion)
-	result, err := execSQL(ctx, ec, query)
+	result, err := execSQL(ctx, ec, query, params...)
 	if err != nil {
 		// check whether this is an SQL error with a suggestion,
 		// so we can improve the error message or apply the suggestion if there's one
@@ -49,22 +59,26 @@ func ExecSQL(ctx context.Context, ec plug.ExecContext, query string) (sql.Result
 				return nil, err
 			}
 			// execute the original query
-			return execSQL(ctx, ec, query)
+			return execSQL(ctx, ec, query, params...)
 		}
 	}
 	return result, nil
 }
 
-func execSQL(ctx context.Context, ec plug.ExecContext, query string) (sql.Result, error) {
+func execSQL(ctx context.Context, ec plug.ExecContext, query string, params ...any) (sql.Result, error) {
 	ci, err := ec.ClientInternal(ctx)
 	if err != nil {
 		return nil, err
 	}
+	stmt, err := newStatement(ctx, ec, query, params...)
+	if err != nil {
+		return nil, err
+	}
 	for {
 		if ctx.Err() != nil {
 			return nil, ctx.Err()
 		}
-		r, err := ci.Client().SQL().Execute(ctx, query)
+		r, err := ci.Client().SQL().ExecuteStatement(ctx, stmt)
 		// If Go client cannot find a connection, it returns immediately with ErrIO
 		// Retry logic here
 		if err != nil {
@@ -78,6 +92,73 @@ func execSQL(ctx context.Context, ec plug.ExecContext, query string) (sql.Result
 	}
 }
 
+// newStatement creates a statement for the given query using the cursor buffer size and the schema in the properties.
+// If the context has a deadline, the query timeout is set to the remaining time,
+// so the query is cancelled on the server side as well when the context times out.
+func newStatement(ctx context.Context, ec plug.ExecContext, query string, params ...any) (sql.Statement, error) {
+	stmt := sql.NewStatement(query, params...)
+	if cbs := ec.Props().GetInt(PropertyCursorBufferSize); cbs != 0 {
+		if err := stmt.SetCursorBufferSize(int(cbs)); err != nil {
+			return stmt, fmt.Errorf("--%s must be in the range [0, %d]", PropertyCursorBufferSize, math.MaxInt32)
+		}
+	}
+	if schema := ec.Props().GetString(PropertySchema); schema != "" {
+		stmt.SetSchema(schema)
+	}
+	if dl, ok := ctx.Deadline(); ok {
+		t := time.Until(dl)
+		// zero query timeout means no timeout
+		if t < time.Millisecond {
+			t = time.Millisecond
+		}
+		stmt.SetQueryTimeout(t)
+	}
+	return stmt, nil
+}
+
+// ParseParams parses the given query parameters.
+// See ParseParam for the format.
+func ParseParams(ss []string) ([]any, error) {
+	if len(ss) == 0 {
+		return nil, nil
+	}
+	params := make([]any, len(ss))
+	for i, s := range ss {
+		p, err := ParseParam(s)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %d: %w", i+1, err)
+		}
+		params[i] = p
+	}
+	return params, nil
+}
+
+// ParseParam parses a query parameter in the TYPE:VALUE format, e.g., i32:42.
+// The types are the same with the key and value types, e.g., compact:com.acme.User:{"id": 1}.
+// null is the NULL parameter.
+func ParseParam(s string) (any, error) {
+	if s == paramNull {
+		return nil, nil
+	}
+	// the Compact and Portable type names contain colons
+	colons := 1
+	ls := strings.ToLower(s)
+	if strings.HasPrefix(ls, internal.TypeNamePrefixCompact) {
+		colons = 2
+	} else if strings.HasPrefix(ls, internal.TypeNamePrefixPortable) {
+		colons = 3
+	}
+	idx := -1
+	for i := 0; i < colons; i++ {
+		next := strings.Index(s[idx+1:], ":")
+		if next < 0 {
+			return nil, fmt.Errorf("%s: parameters must be in the TYPE:VALUE format, e.g., i32:42", s)
+		}
+		idx += next + 1
+	}
+	return internal.ConvertString(s[idx+1:], s[:idx])
+}
+
 func adaptSQLError(err error) error {
 	var serr *sql.Error
 	if !errors.As(err, &serr) {
@@ -88,20 +169,54 @@ func adaptSQLError(err error) error {
 	return fmt.Errorf(serr.Message)
 }
 
+// QueryStats contains the timing and the row count of a query.
+type QueryStats struct {
+	// Elapsed is the time from the start of the query until the last row is received.
+	Elapsed time.Duration
+	// FirstRow is the time from the start of the query until the first row is received.
+	// It is zero if there are no rows.
+	FirstRow time.Duration
+	// Rows is the number of returned rows, or the update count if the query does not return rows.
+	Rows int64
+}
+
+func (qs QueryStats) String() string {
+	first := "-"
+	if qs.FirstRow > 0 {
+		first = qs.FirstRow.Round(time.Microsecond).String()
+	}
+	return fmt.Sprintf("Time: %s, time to first row: %s, rows: %d", qs.Elapsed.Round(time.Microsecond), first, qs.Rows)
+}
+
+// UpdateOutput writes the rows in the result to the output.
 func UpdateOutput(ctx context.Context, ec plug.ExecContext, res sql.Result) error {
+	_, err := UpdateOutputWithStats(ctx, ec, res, time.Now())
+	return err
+}
+
+// UpdateOutputWithStats writes the rows in the result to the output and returns the query statistics.
+// start is the time the query was started.
+// If the context is cancelled, e.g., by pressing Ctrl+C, the query is closed so it is cancelled on the server side as well.
+func UpdateOutputWithStats(ctx context.Context, ec plug.ExecContext, res sql.Result, start time.Time) (QueryStats, error) {
 	if !res.IsRowSet() {
 		ec.PrintlnUnnecessary("OK Executed the query.")
-		return nil
+		uc := res.UpdateCount()
+		if uc < 0 {
+			uc = 0
+		}
+		return QueryStats{Elapsed: time.Since(start), Rows: uc}, nil
 	}
 	it, err := res.Iterator()
 	if err != nil {
-		return err
+		return QueryStats{}, err
 	}
 	rowCh := make(chan output.Row, 1)
 	errCh := make(chan error, 1)
 	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, os.Kill)
 	defer stop()
 	var count int64
+	// firstRow is the time to the first row in nanoseconds
+	var firstRow int64
 	go func(count *int64) {
 		var row sql.Row
 		var err error
@@ -111,17 +226,13 @@ func UpdateOutput(ctx context.Context, ec plug.ExecContext, res sql.Result) erro
 			if err != nil {
 				break
 			}
-			atomic.AddInt64(count, 1)
-			// have to create a new output row
-			// since it is processed by another goroutine
-			cols := row.Metadata().Columns()
-			orow := make(output.Row, len(cols))
-			for i, col := range cols {
-				orow[i] = output.Column{
-					Name:  col.Name(),
-					Type:  convertSQLType(col.Type()),
-					Value: check.MustValue(row.Get(i)),
-				}
+			var orow output.Row
+			orow, err = OutputRow(row)
+			if err != nil {
+				break
+			}
+			if atomic.AddInt64(count, 1) == 1 {
+				atomic.StoreInt64(&firstRow, int64(time.Since(start)))
 			}
 			select {
 			case rowCh <- orow:
@@ -134,17 +245,81 @@ func UpdateOutput(ctx context.Context, ec plug.ExecContext, res sql.Result) erro
 	}(&count)
 	// XXX: the error is ignored, the reason must be noted.
 	_ = ec.AddOutputStream(ctx, rowCh)
+	stats := func() QueryStats {
+		return QueryStats{
+			Elapsed:  time.Since(start),
+			FirstRow: time.Duration(atomic.LoadInt64(&firstRow)),
+			Rows:     atomic.LoadInt64(&count),
+		}
+	}
 	select {
 	case err = <-errCh:
 		if err != nil {
-			return err
+			return stats(), err
 		}
-		msg := fmt.Sprintf("OK Returned %d rows.", atomic.LoadInt64(&count))
+		qs := stats()
+		msg := fmt.Sprintf("OK Returned %d rows.", qs.Rows)
 		ec.PrintlnUnnecessary(msg)
-		return nil
+		return qs, nil
 	case <-ctx.Done():
-		return ctx.Err()
+		// cancelling the context does not stop the query on the server side, closing the result does
+		if err := res.Close(); err != nil {
+			ec.Logger().Debugf("Closing the query: %s", err.Error())
+		}
+		return stats(), ctx.Err()
+	}
+}
+
+// OutputRow converts the given SQL row to an output row.
+// A new output row is created, so it can be processed by another goroutine.
+func OutputRow(row sql.Row) (output.Row, error) {
+	cols := row.Metadata().Columns()
+	orow := make(output.Row, len(cols))
+	for i, col := range cols {
+		v, err := row.Get(i)
+		if err != nil {
+			return nil, err
+		}
+		orow[i] = output.Column{
+			Name:  col.Name(),
+			Type:  convertSQLType(col.Type()),
+			Value: v,
+		}
+	}
+	return orow, nil
+}
+
+// QueryScalar executes the query and returns the text of the first column of its first row.
+// Returns an empty string if that column is NULL.
+func QueryScalar(ctx context.Context, ec plug.ExecContext, query string, params ...any) (string, error) {
+	res, err := ExecSQL(ctx, ec, query, params...)
+	if err != nil {
+		return "", err
+	}
+	defer res.Close()
+	if !res.IsRowSet() {
+		return "", errors.New("the query does not return rows")
+	}
+	it, err := res.Iterator()
+	if err != nil {
+		return "", err
+	}
+	if !it.HasNext() {
+		return "", errors.New("the query returned no rows")
+	}
+	row, err := it.Next()
+	if err != nil {
+		return "", err
+	}
+	orow, err := OutputRow(row)
+	if err != nil {
+		return "", err
+	}
+	col := orow[0]
+	if check.IsNil(col.Value) {
+		return "", nil
 	}
+	return col.Text(), nil
 }
 
 var sqlTypeToSerializationType = map[sql.ColumnType]int32{
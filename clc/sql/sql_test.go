@@ -0,0 +1,69 @@
+package sql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/stretchr/testify/require"
+
+	clcsql "github.com/hazelcast/hazelcast-commandline-client/clc/sql"
+)
+
+func TestParseParam(t *testing.T) {
+	testCases := []struct {
+		param  string
+		target any
+	}{
+		{param: "string:foo", target: "foo"},
+		{param: "string:", target: ""},
+		{param: "string:a:b", target: "a:b"},
+		{param: "i32:42", target: int32(42)},
+		{param: "I64:-1", target: int64(-1)},
+		{param: "bool:true", target: true},
+		{param: `json:{"a": "b:c"}`, target: serialization.JSON(`{"a": "b:c"}`)},
+		{param: "null", target: nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.param, func(t *testing.T) {
+			v, err := clcsql.ParseParam(tc.param)
+			require.NoError(t, err)
+			require.Equal(t, tc.target, v)
+		})
+	}
+}
+
+func TestParseParams_Error(t *testing.T) {
+	_, err := clcsql.ParseParams([]string{"i32:1", "foo"})
+	require.EqualError(t, err, "parameter 2: foo: parameters must be in the TYPE:VALUE format, e.g., i32:42")
+	_, err = clcsql.ParseParams([]string{"i32:foo"})
+	require.Error(t, err)
+}
+
+func TestIsDDL(t *testing.T) {
+	require.True(t, clcsql.IsDDL("CREATE MAPPING foo TYPE IMap"))
+	require.True(t, clcsql.IsDDL("  drop mapping foo"))
+	require.True(t, clcsql.IsDDL("ALTER JOB foo SUSPEND"))
+	require.False(t, clcsql.IsDDL("SELECT * FROM foo"))
+	require.False(t, clcsql.IsDDL(""))
+}
+
+func TestIsQuery(t *testing.T) {
+	require.True(t, clcsql.IsQuery("SELECT * FROM foo"))
+	require.True(t, clcsql.IsQuery("  show mappings"))
+	require.True(t, clcsql.IsQuery("(SELECT 1) UNION (SELECT 2)"))
+	require.False(t, clcsql.IsQuery("INSERT INTO foo VALUES (1)"))
+	require.False(t, clcsql.IsQuery("DELETE FROM foo"))
+	require.False(t, clcsql.IsQuery(""))
+}
+
+func TestQueryStats_String(t *testing.T) {
+	qs := clcsql.QueryStats{
+		Elapsed:  12345678 * time.Nanosecond,
+		FirstRow: 3210987 * time.Nanosecond,
+		Rows:     10,
+	}
+	require.Equal(t, "Time: 12.346ms, time to first row: 3.211ms, rows: 10", qs.String())
+	qs = clcsql.QueryStats{Elapsed: 2 * time.Second}
+	require.Equal(t, "Time: 2s, time to first row: -, rows: 0", qs.String())
+}
@@ -0,0 +1,178 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/hazelcast/hazelcast-commandline-client/internal/check"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/output"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/terminal"
+)
+
+const (
+	DefaultWatchInterval = 2 * time.Second
+	DefaultWatchRows     = 20
+	// clearScreen moves the cursor to the top left corner and clears the screen
+	clearScreen = "\033[H\033[2J"
+)
+
+// WatchOptions are the options of Watch.
+type WatchOptions struct {
+	// Interval is the duration between the executions of a finite query,
+	// and between the refreshes of the displayed rows of a streaming query.
+	Interval time.Duration
+	// MaxRows is the maximum number of rows displayed for a streaming query.
+	MaxRows int
+}
+
+// Watch executes the query repeatedly and redraws its rows until it is interrupted.
+// A finite query is executed again after opts.Interval passes.
+// A query which is running longer than opts.Interval is a streaming query,
+// its last opts.MaxRows rows are redrawn every opts.Interval.
+// Returns nil if the context is cancelled or Ctrl+C is pressed.
+func Watch(ctx context.Context, ec plug.ExecContext, query string, opts WatchOptions, params ...any) error {
+	if opts.Interval <= 0 {
+		return errors.New("watch interval must be positive")
+	}
+	if opts.MaxRows <= 0 {
+		return errors.New("watch rows must be positive")
+	}
+	// the other statements are rejected before running them, since they may modify the data
+	if !IsQuery(query) {
+		return errors.New("only the queries which return rows can be watched")
+	}
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, os.Kill)
+	defer stop()
+	w := watcher{ec: ec, query: query, opts: opts, clear: isTerminal(ec.Stdout())}
+	for {
+		if err := w.watchOnce(ctx, params...); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(opts.Interval):
+		}
+	}
+}
+
+type watcher struct {
+	ec    plug.ExecContext
+	query string
+	opts  WatchOptions
+	// clear is true if the screen is cleared before redrawing the rows
+	clear bool
+}
+
+// watchOnce executes the query and redraws its rows.
+// Returns when the query finishes.
+func (w watcher) watchOnce(ctx context.Context, params ...any) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	res, err := ExecSQL(ctx, w.ec, w.query, params...)
+	if err != nil {
+		return err
+	}
+	// closing the result cancels the query on the cluster
+	defer res.Close()
+	if !res.IsRowSet() {
+		return errors.New("only the queries which return rows can be watched")
+	}
+	it, err := res.Iterator()
+	if err != nil {
+		return err
+	}
+	rowCh := make(chan output.Row)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(rowCh)
+		for it.HasNext() {
+			row, err := it.Next()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			orow, err := OutputRow(row)
+			if err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case rowCh <- orow:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	ticker := time.NewTicker(w.opts.Interval)
+	defer ticker.Stop()
+	var rows []output.Row
+	var streaming bool
+	for {
+		select {
+		case row, ok := <-rowCh:
+			if !ok {
+				select {
+				case err := <-errCh:
+					return err
+				default:
+				}
+				return w.redraw(ctx, rows, streaming)
+			}
+			rows = append(rows, row)
+			if streaming {
+				rows = lastRows(rows, w.opts.MaxRows)
+			}
+		case <-ticker.C:
+			// the query is still running, so it is a streaming query
+			streaming = true
+			rows = lastRows(rows, w.opts.MaxRows)
+			if err := w.redraw(ctx, rows, streaming); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (w watcher) redraw(ctx context.Context, rows []output.Row, streaming bool) error {
+	if w.clear {
+		check.I2(fmt.Fprint(w.ec.Stdout(), clearScreen))
+	}
+	header := fmt.Sprintf("Every %s: %s (%s)", w.opts.Interval, summaryStatement(w.query), time.Now().Format(time.DateTime))
+	if streaming {
+		header = fmt.Sprintf("%s, streaming, last %d rows", header, w.opts.MaxRows)
+	}
+	w.ec.PrintlnUnnecessary(header)
+	if len(rows) == 0 {
+		w.ec.PrintlnUnnecessary("OK No rows.")
+		return nil
+	}
+	return w.ec.AddOutputRows(ctx, rows...)
+}
+
+// lastRows returns the last n rows.
+func lastRows(rows []output.Row, n int) []output.Row {
+	if len(rows) <= n {
+		return rows
+	}
+	// copying the rows, so the discarded rows can be garbage collected
+	return append([]output.Row(nil), rows[len(rows)-n:]...)
+}
+
+// isTerminal returns true if the given writer is a terminal.
+func isTerminal(w any) bool {
+	if _, ok := w.(terminal.Stater); !ok {
+		return false
+	}
+	return !terminal.IsPipe(w)
+}
@@ -3,7 +3,10 @@ package main
 import (
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands"
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/atomic_long"
+	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/cache"
+	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/cardinality_estimator"
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/config"
+	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/data"
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/demo"
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/job"
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/list"
@@ -12,6 +15,7 @@ import (
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/object"
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/project"
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/queue"
+	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/schema"
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/set"
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/snapshot"
 	_ "github.com/hazelcast/hazelcast-commandline-client/base/commands/sql"
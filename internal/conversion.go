@@ -1,27 +1,49 @@
 package internal
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/hazelcast/hazelcast-go-client/serialization"
+
+	iserialization "github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
 )
 
 // supported types
 const (
-	TypeNameString  = "string"
-	TypeNameBoolean = "bool"
-	TypeNameJSON    = "json"
-	TypeNameInt8    = "i8"
-	TypeNameInt16   = "i16"
-	TypeNameInt32   = "i32"
-	TypeNameInt64   = "i64"
-	TypeNameFloat32 = "f32"
-	TypeNameFloat64 = "f64"
+	TypeNameString     = "string"
+	TypeNameBoolean    = "bool"
+	TypeNameJSON       = "json"
+	TypeNameInt8       = "i8"
+	TypeNameInt16      = "i16"
+	TypeNameInt32      = "i32"
+	TypeNameInt64      = "i64"
+	TypeNameFloat32    = "f32"
+	TypeNameFloat64    = "f64"
+	TypeNameUUID       = "uuid"
+	TypeNameBigInt     = "bigint"
+	TypeNameDecimal    = "decimal"
+	TypeNameDate       = "date"
+	TypeNameTime       = "time"
+	TypeNameDateTime   = "datetime"
+	TypeNameDateTimeTZ = "datetime-tz"
+	TypeNameBytes      = "bytes"
+	TypeNameChar       = "char"
+	// TypeNameAuto infers the type from the value
+	TypeNameAuto = "auto"
+	// TypeNameSuffixArray is the suffix for the array types, e.g., i32[]
+	TypeNameSuffixArray = "[]"
+	// TypeNamePrefixCompact is the prefix for the Compact types defined in the configuration, e.g., compact:com.acme.User
+	TypeNamePrefixCompact = "compact:"
+	// TypeNamePrefixPortable is the prefix for the Portable classes defined in the configuration, e.g., portable:1:2
+	TypeNamePrefixPortable = "portable:"
 )
 
 var SupportedTypeNames = []string{
@@ -34,6 +56,25 @@ var SupportedTypeNames = []string{
 	TypeNameInt64,
 	TypeNameFloat32,
 	TypeNameFloat64,
+	TypeNameUUID,
+	TypeNameBigInt,
+	TypeNameDecimal,
+	TypeNameDate,
+	TypeNameTime,
+	TypeNameDateTime,
+	TypeNameDateTimeTZ,
+	TypeNameBytes,
+	TypeNameChar,
+	TypeNameAuto,
+	TypeNameBoolean + TypeNameSuffixArray,
+	TypeNameInt8 + TypeNameSuffixArray,
+	TypeNameInt16 + TypeNameSuffixArray,
+	TypeNameInt32 + TypeNameSuffixArray,
+	TypeNameInt64 + TypeNameSuffixArray,
+	TypeNameFloat32 + TypeNameSuffixArray,
+	TypeNameFloat64 + TypeNameSuffixArray,
+	TypeNameString + TypeNameSuffixArray,
+	TypeNameChar + TypeNameSuffixArray,
 }
 
 func ConvertString(value, valueType string) (interface{}, error) {
@@ -43,7 +84,14 @@ func ConvertString(value, valueType string) (interface{}, error) {
 		i   int64
 		f   float64
 	)
+	if len(valueType) > len(TypeNamePrefixCompact) && strings.EqualFold(valueType[:len(TypeNamePrefixCompact)], TypeNamePrefixCompact) {
+		// Compact type names are case-sensitive
+		return iserialization.NewCompactValue(valueType[len(TypeNamePrefixCompact):], value)
+	}
 	valueType = strings.ToLower(valueType)
+	if strings.HasPrefix(valueType, TypeNamePrefixPortable) {
+		return makePortableValue(value, valueType)
+	}
 	switch valueType {
 	// "" is for default/empty
 	case TypeNameString, "":
@@ -79,8 +127,58 @@ func ConvertString(value, valueType string) (interface{}, error) {
 		cv = float32(f)
 	case TypeNameFloat64:
 		cv, err = strconv.ParseFloat(value, 64)
+	case TypeNameAuto:
+		return ConvertString(value, InferTypeName(value))
+	case TypeNameUUID:
+		cv, err = iserialization.ParseUUID(value)
+	case TypeNameBigInt:
+		b, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			err = fmt.Errorf("invalid big integer: %s", value)
+			break
+		}
+		cv = b
+	case TypeNameDecimal:
+		cv, err = iserialization.ParseDecimal(value)
+	case TypeNameDate:
+		cv, err = iserialization.ParseLocalDate(value)
+	case TypeNameTime:
+		cv, err = iserialization.ParseLocalTime(value)
+	case TypeNameDateTime:
+		cv, err = iserialization.ParseLocalDateTime(value)
+	case TypeNameDateTimeTZ:
+		cv, err = iserialization.ParseOffsetDateTime(value)
+	case TypeNameBytes:
+		cv, err = parseBytes(value)
+	case TypeNameChar:
+		cv, err = iserialization.ParseChar(value)
+	case TypeNameBoolean + TypeNameSuffixArray:
+		cv, err = convertArray[bool](value, TypeNameBoolean)
+	case TypeNameInt8 + TypeNameSuffixArray:
+		// byte arrays are signed in Java
+		var vs []int8
+		vs, err = convertArray[int8](value, TypeNameInt8)
+		bs := make([]byte, len(vs))
+		for i, v := range vs {
+			bs[i] = byte(v)
+		}
+		cv = bs
+	case TypeNameInt16 + TypeNameSuffixArray:
+		cv, err = convertArray[int16](value, TypeNameInt16)
+	case TypeNameInt32 + TypeNameSuffixArray:
+		cv, err = convertArray[int32](value, TypeNameInt32)
+	case TypeNameInt64 + TypeNameSuffixArray:
+		cv, err = convertArray[int64](value, TypeNameInt64)
+	case TypeNameFloat32 + TypeNameSuffixArray:
+		cv, err = convertArray[float32](value, TypeNameFloat32)
+	case TypeNameFloat64 + TypeNameSuffixArray:
+		cv, err = convertArray[float64](value, TypeNameFloat64)
+	case TypeNameString + TypeNameSuffixArray:
+		cv, err = convertArray[string](value, TypeNameString)
+	case TypeNameChar + TypeNameSuffixArray:
+		cv, err = convertArray[uint16](value, TypeNameChar)
 	default:
-		err = fmt.Errorf("unknown type '%s', provide one of %s", valueType, strings.Join(SupportedTypeNames, ", "))
+		err = fmt.Errorf("unknown type '%s', provide one of %s, %sTYPE or %sFACTORY:CLASS", valueType, strings.Join(SupportedTypeNames, ", "), TypeNamePrefixCompact, TypeNamePrefixPortable)
 	}
 	if errors.Is(err, strconv.ErrSyntax) {
 		err = fmt.Errorf(`can not convert "%s" to %s, unknown syntax`, value, valueType)
@@ -90,6 +188,106 @@ func ConvertString(value, valueType string) (interface{}, error) {
 	return cv, err
 }
 
+// InferTypeName returns the most specific type name for the given value.
+// Integers are inferred as i64 and floating point numbers as f64.
+// Returns string if the type cannot be inferred.
+func InferTypeName(value string) string {
+	switch value {
+	case "true", "false":
+		return TypeNameBoolean
+	}
+	if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return TypeNameInt64
+	}
+	// ParseFloat accepts values like NaN and Inf, which are more likely to be strings
+	if strings.ContainsAny(value, "0123456789") && !strings.ContainsAny(value, "xXpP_") {
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return TypeNameFloat64
+		}
+	}
+	if _, err := iserialization.ParseUUID(value); err == nil {
+		return TypeNameUUID
+	}
+	if _, err := iserialization.ParseLocalDate(value); err == nil {
+		return TypeNameDate
+	}
+	if _, err := iserialization.ParseLocalDateTime(value); err == nil {
+		return TypeNameDateTime
+	}
+	if _, err := iserialization.ParseOffsetDateTime(value); err == nil {
+		return TypeNameDateTimeTZ
+	}
+	if strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[") {
+		if json.Valid([]byte(value)) {
+			return TypeNameJSON
+		}
+	}
+	return TypeNameString
+}
+
+// parseBytes parses a base64 encoded string, or a hex encoded string if it is prefixed with 0x.
+func parseBytes(value string) ([]byte, error) {
+	if strings.HasPrefix(value, "0x") || strings.HasPrefix(value, "0X") {
+		b, err := hex.DecodeString(value[2:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex string: %s", value)
+		}
+		return b, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 string: %s", value)
+	}
+	return b, nil
+}
+
+// convertArray converts the items of the given JSON array to the given type.
+// Numbers and booleans may be given with or without quotes.
+func convertArray[T any](value, itemType string) ([]T, error) {
+	d := json.NewDecoder(strings.NewReader(value))
+	d.UseNumber()
+	var items []any
+	if err := d.Decode(&items); err != nil {
+		return nil, fmt.Errorf("expected a JSON array, e.g., [1, 2, 3], but got: %s", value)
+	}
+	r := make([]T, len(items))
+	for i, item := range items {
+		var s string
+		switch v := item.(type) {
+		case string:
+			s = v
+		case json.Number:
+			s = v.String()
+		case bool:
+			s = strconv.FormatBool(v)
+		default:
+			return nil, fmt.Errorf("item %d: unexpected value: %v", i, item)
+		}
+		cv, err := ConvertString(s, itemType)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		r[i] = cv.(T)
+	}
+	return r, nil
+}
+
+func makePortableValue(value, valueType string) (any, error) {
+	fid, cid, ok := strings.Cut(valueType[len(TypeNamePrefixPortable):], ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid Portable type: %s (should be %sFACTORY:CLASS)", valueType, TypeNamePrefixPortable)
+	}
+	f, err := strconv.ParseInt(fid, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Portable factory ID: %s", fid)
+	}
+	c, err := strconv.ParseInt(cid, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Portable class ID: %s", cid)
+	}
+	return iserialization.NewPortableValue(int32(f), int32(c), value)
+}
+
 func init() {
 	sort.Slice(SupportedTypeNames, func(i, j int) bool {
 		return SupportedTypeNames[i] < SupportedTypeNames[j]
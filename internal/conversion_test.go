@@ -1,12 +1,20 @@
 package internal
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
 	"github.com/stretchr/testify/require"
 )
 
+func bigInt(s string) *big.Int {
+	b, _ := new(big.Int).SetString(s, 10)
+	return b
+}
+
 func TestConvertString(t *testing.T) {
 	type args struct {
 		value     string
@@ -139,6 +147,166 @@ func TestConvertString(t *testing.T) {
 			},
 			want: float64(0.34),
 		},
+		{
+			name: "valid uuid",
+			args: args{
+				value:     "6c1a5f0e-6a36-4d2d-9b1f-0a6b1e0c4a21",
+				valueType: TypeNameUUID,
+			},
+			want: types.NewUUIDWith(0x6c1a5f0e6a364d2d, 0x9b1f0a6b1e0c4a21),
+		},
+		{
+			name: "invalid uuid",
+			args: args{
+				value:     "6c1a5f0e-6a36-4d2d-9b1f",
+				valueType: TypeNameUUID,
+			},
+			isErr: true,
+		},
+		{
+			name: "valid bigint",
+			args: args{
+				value:     "123456789012345678901234567890",
+				valueType: TypeNameBigInt,
+			},
+			want: bigInt("123456789012345678901234567890"),
+		},
+		{
+			name: "invalid bigint",
+			args: args{
+				value:     "1.5",
+				valueType: TypeNameBigInt,
+			},
+			isErr: true,
+		},
+		{
+			name: "valid decimal",
+			args: args{
+				value:     "-12.345",
+				valueType: TypeNameDecimal,
+			},
+			want: types.NewDecimal(big.NewInt(-12345), 3),
+		},
+		{
+			name: "valid date",
+			args: args{
+				value:     "2023-02-03",
+				valueType: TypeNameDate,
+			},
+			want: types.LocalDate(time.Date(2023, 2, 3, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "invalid date",
+			args: args{
+				value:     "03/02/2023",
+				valueType: TypeNameDate,
+			},
+			isErr: true,
+		},
+		{
+			name: "valid time",
+			args: args{
+				value:     "04:05:06",
+				valueType: TypeNameTime,
+			},
+			want: types.LocalTime(time.Date(0, 1, 1, 4, 5, 6, 0, time.UTC)),
+		},
+		{
+			name: "valid datetime",
+			args: args{
+				value:     "2023-02-03T04:05:06",
+				valueType: TypeNameDateTime,
+			},
+			want: types.LocalDateTime(time.Date(2023, 2, 3, 4, 5, 6, 0, time.UTC)),
+		},
+		{
+			name: "valid datetime-tz",
+			args: args{
+				value:     "2023-02-03T04:05:06Z",
+				valueType: TypeNameDateTimeTZ,
+			},
+			want: types.OffsetDateTime(time.Date(2023, 2, 3, 4, 5, 6, 0, time.UTC)),
+		},
+		{
+			name: "valid base64 bytes",
+			args: args{
+				value:     "AQID",
+				valueType: TypeNameBytes,
+			},
+			want: []byte{1, 2, 3},
+		},
+		{
+			name: "valid hex bytes",
+			args: args{
+				value:     "0x010203",
+				valueType: TypeNameBytes,
+			},
+			want: []byte{1, 2, 3},
+		},
+		{
+			name: "invalid bytes",
+			args: args{
+				value:     "0xZZ",
+				valueType: TypeNameBytes,
+			},
+			isErr: true,
+		},
+		{
+			name: "valid char",
+			args: args{
+				value:     "ç",
+				valueType: TypeNameChar,
+			},
+			want: uint16('ç'),
+		},
+		{
+			name: "invalid char",
+			args: args{
+				value:     "ab",
+				valueType: TypeNameChar,
+			},
+			isErr: true,
+		},
+		{
+			name: "valid int32 array",
+			args: args{
+				value:     "[1, \"2\", 3]",
+				valueType: TypeNameInt32 + TypeNameSuffixArray,
+			},
+			want: []int32{1, 2, 3},
+		},
+		{
+			name: "valid int8 array",
+			args: args{
+				value:     "[1, -1]",
+				valueType: TypeNameInt8 + TypeNameSuffixArray,
+			},
+			want: []byte{1, 255},
+		},
+		{
+			name: "valid string array",
+			args: args{
+				value:     "[\"a\", \"b\"]",
+				valueType: TypeNameString + TypeNameSuffixArray,
+			},
+			want: []string{"a", "b"},
+		},
+		{
+			name: "invalid int16 array, overflow",
+			args: args{
+				value:     "[1, 40000]",
+				valueType: TypeNameInt16 + TypeNameSuffixArray,
+			},
+			isErr: true,
+		},
+		{
+			name: "invalid array",
+			args: args{
+				value:     "1, 2",
+				valueType: TypeNameInt64 + TypeNameSuffixArray,
+			},
+			isErr: true,
+		},
 	}
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
@@ -154,3 +322,30 @@ func TestConvertString(t *testing.T) {
 		})
 	}
 }
+
+func TestInferTypeName(t *testing.T) {
+	testCases := []struct {
+		value    string
+		typeName string
+	}{
+		{value: "true", typeName: TypeNameBoolean},
+		{value: "True", typeName: TypeNameString},
+		{value: "-42", typeName: TypeNameInt64},
+		{value: "3.14", typeName: TypeNameFloat64},
+		{value: "1e3", typeName: TypeNameFloat64},
+		{value: "NaN", typeName: TypeNameString},
+		{value: "6c1a5f0e-6a36-4d2d-9b1f-0a6b1e0c4a21", typeName: TypeNameUUID},
+		{value: "2023-02-03", typeName: TypeNameDate},
+		{value: "2023-02-03T04:05:06", typeName: TypeNameDateTime},
+		{value: "2023-02-03T04:05:06+03:00", typeName: TypeNameDateTimeTZ},
+		{value: `{"foo": 1}`, typeName: TypeNameJSON},
+		{value: `[1, 2]`, typeName: TypeNameJSON},
+		{value: `{foo}`, typeName: TypeNameString},
+		{value: "hello", typeName: TypeNameString},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			require.Equal(t, tc.typeName, InferTypeName(tc.value))
+		})
+	}
+}
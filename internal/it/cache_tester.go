@@ -0,0 +1,14 @@
+package it
+
+import (
+	"testing"
+)
+
+// CacheTester runs the given function with a unique cache name.
+// The test cluster has a cache configuration matching the name, so the cache can be used with the cache commands.
+func CacheTester(t *testing.T, fn func(tcx TestContext, name string)) {
+	tcx := TestContext{T: t}
+	tcx.Tester(func(tcx TestContext) {
+		fn(tcx, NewUniqueObjectName("cache"))
+	})
+}
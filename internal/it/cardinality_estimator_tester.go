@@ -0,0 +1,13 @@
+package it
+
+import (
+	"testing"
+)
+
+// CardinalityEstimatorTester runs the given function with a unique CardinalityEstimator name.
+func CardinalityEstimatorTester(t *testing.T, fn func(tcx TestContext, name string)) {
+	tcx := TestContext{T: t}
+	tcx.Tester(func(tcx TestContext) {
+		fn(tcx, NewUniqueObjectName("cardinalityEstimator"))
+	})
+}
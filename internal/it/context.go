@@ -45,6 +45,10 @@ func (c CommandContext) AddStringFlag(long, short, value string, required bool,
 	panic("implement me")
 }
 
+func (c CommandContext) AddStringSliceFlag(long, short string, required bool, help string) {
+	panic("implement me")
+}
+
 func (c CommandContext) AddBoolFlag(long, short string, value bool, required bool, help string) {
 	panic("implement me")
 }
@@ -53,6 +57,14 @@ func (c CommandContext) AddIntFlag(long, short string, value int64, required boo
 	panic("implement me")
 }
 
+func (c CommandContext) SetFlagCompletion(flag string, f plug.CompletionFunc) {
+	panic("implement me")
+}
+
+func (c CommandContext) SetArgCompletion(f plug.CompletionFunc) {
+	panic("implement me")
+}
+
 func (c CommandContext) SetPositionalArgCount(min, max int) {
 	panic("implement me")
 }
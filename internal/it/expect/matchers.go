@@ -44,6 +44,9 @@ func (c ContainsMatcher) Match(s string) bool {
 	return strings.Contains(s, c.pattern)
 }
 
+// literalDollar is the placeholder of an escaped $ in DollarMatcher patterns.
+const literalDollar = "\x00"
+
 type DollarMatcher struct {
 	pattern string
 }
@@ -57,16 +60,23 @@ func (m DollarMatcher) Match(s string) bool {
 func (m DollarMatcher) normalize(s string) string {
 	// 1. trim spaces before and after $, if $ appears at the beginng or the end
 	// 2. replace $ with \b+
+	// 3. replace \$ with a literal $
 	var lines []string
 	scn := bufio.NewScanner(strings.NewReader(s))
 	for scn.Scan() {
 		line := strings.TrimSpace(scn.Text())
+		line = strings.ReplaceAll(line, `\$`, literalDollar)
 		line = strings.ReplaceAll(line, `\`, `\\`)
 		line = strings.ReplaceAll(line, `+`, `\+`)
 		line = strings.ReplaceAll(line, `*`, `\*`)
+		line = strings.ReplaceAll(line, `?`, `\?`)
+		line = strings.ReplaceAll(line, `(`, `\(`)
+		line = strings.ReplaceAll(line, `)`, `\)`)
 		line = strings.ReplaceAll(line, "$", "\\s*")
 		line = strings.ReplaceAll(line, "[", "\\[")
 		line = strings.ReplaceAll(line, "|", "\\|")
+		line = strings.ReplaceAll(line, "{", "\\{")
+		line = strings.ReplaceAll(line, literalDollar, `\$`)
 		lines = append(lines, line)
 	}
 	return strings.Join(lines, "\n")
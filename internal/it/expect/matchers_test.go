@@ -84,6 +84,24 @@ func TestDollarMatcher_Match(t *testing.T) {
 			s:       strings.ReplaceAll(pattern, "$", "%"),
 			matches: false,
 		},
+		{
+			name:    "regexp characters",
+			pattern: "$Use * and ? in COUNT(*)$",
+			s:       "Use * and ? in COUNT(*)",
+			matches: true,
+		},
+		{
+			name:    "literal dollar",
+			pattern: `$Refer to variables as \${NAME}$`,
+			s:       "Refer to variables as ${NAME}",
+			matches: true,
+		},
+		{
+			name:    "literal dollar is not a space",
+			pattern: `$Refer to variables as \${NAME}$`,
+			s:       "Refer to variables as {NAME}",
+			matches: false,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -133,6 +133,10 @@ func XMLConfig(clusterName string, port int) string {
 					<class-name>com.hazelcast.client.test.SampleMapStore</class-name>
 				</map-store>
 			</map>
+			<map name="test-map-*-journal">
+				<event-journal enabled="true" />
+			</map>
+			<cache name="test-cache-*" />
         </hazelcast>
 	`, clusterName, port)
 }
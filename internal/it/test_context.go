@@ -53,21 +53,23 @@ type TestContext struct {
 	Client         *hz.Client
 	ClientConfig   *hz.Config
 	ConfigCallback func(testContext TestContext)
-	Before         func(tcx TestContext)
-	After          func(tcx TestContext)
-	ConfigPath     string
-	LogPath        string
-	LogLevel       string
-	ExpectStdout   *expect.Expect
-	ExpectStderr   *expect.Expect
-	Viridian       *ViridianAPI
-	UseViridian    bool
-	homePath       string
-	stderr         *ProtectedBuffer
-	stdout         *ProtectedBuffer
-	stdinR         io.Reader
-	stdinW         io.Writer
-	main           *cmd.Main
+	// ExtraConfig is added to the CLC configuration, e.g., the serialization settings
+	ExtraConfig  map[string]any
+	Before       func(tcx TestContext)
+	After        func(tcx TestContext)
+	ConfigPath   string
+	LogPath      string
+	LogLevel     string
+	ExpectStdout *expect.Expect
+	ExpectStderr *expect.Expect
+	Viridian     *ViridianAPI
+	UseViridian  bool
+	homePath     string
+	stderr       *ProtectedBuffer
+	stdout       *ProtectedBuffer
+	stdinR       io.Reader
+	stdinW       io.Writer
+	main         *cmd.Main
 }
 
 func (tcx TestContext) HomePath() string {
@@ -133,6 +135,9 @@ func (tcx TestContext) Tester(f func(tcx TestContext)) {
 			tcx.ConfigCallback(tcx)
 		}
 		cfg := ConfigToMap(*tcx.ClientConfig)
+		for k, v := range tcx.ExtraConfig {
+			cfg[k] = v
+		}
 		bytesConfig, err := yaml.Marshal(cfg)
 		if err == nil {
 			// note that checking whether there's no error.
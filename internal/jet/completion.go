@@ -0,0 +1,25 @@
+package jet
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-commandline-client/clc"
+	"github.com/hazelcast/hazelcast-commandline-client/clc/cmd"
+	"github.com/hazelcast/hazelcast-commandline-client/internal/plug"
+)
+
+// CompleteSnapshots suggests the names of the exported snapshots.
+func CompleteSnapshots(ctx context.Context, ec plug.ExecContext) ([]string, error) {
+	names, stop, err := cmd.ExecuteBlocking(ctx, ec, func(ctx context.Context, sp clc.Spinner) ([]string, error) {
+		ci, err := cmd.ClientInternal(ctx, ec, sp)
+		if err != nil {
+			return nil, err
+		}
+		return New(ci, sp, ec.Logger()).GetSnapshotNames(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	stop()
+	return names, nil
+}
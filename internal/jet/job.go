@@ -18,6 +18,9 @@ import (
 	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec/control"
 )
 
+// ExportedSnapshotsMap is the Map which keeps the exported snapshots by their names.
+const ExportedSnapshotsMap = "__jet.exportedSnapshotsCache"
+
 type spinner interface {
 	SetProgress(progress float32)
 }
@@ -116,6 +119,25 @@ func (j Jet) GetJobList(ctx context.Context) ([]control.JobAndSqlSummary, error)
 	return ls, nil
 }
 
+// GetSnapshotNames returns the names of the exported snapshots.
+func (j Jet) GetSnapshotNames(ctx context.Context) ([]string, error) {
+	m, err := j.ci.Client().GetMap(ctx, ExportedSnapshotsMap)
+	if err != nil {
+		return nil, err
+	}
+	ks, err := m.GetKeySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(ks))
+	for _, k := range ks {
+		if s, ok := k.(string); ok {
+			names = append(names, s)
+		}
+	}
+	return names, nil
+}
+
 func (j Jet) TerminateJob(ctx context.Context, jobID int64, terminateMode int32, coordinator types.UUID) error {
 	req := codec.EncodeJetTerminateJobRequest(jobID, terminateMode, coordinator)
 	if _, err := j.ci.InvokeOnRandomTarget(ctx, req, nil); err != nil {
@@ -7,6 +7,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
@@ -78,6 +79,21 @@ func StatusToString(status int32) string {
 	return "UNKNOWN"
 }
 
+// IDToString returns the job ID in the 0000-0000-0000-0000 format.
+func IDToString(id int64) string {
+	buf := []byte("0000-0000-0000-0000")
+	hex := []byte(strconv.FormatInt(id, 16))
+	j := 18
+	for i := len(hex) - 1; i >= 0; i-- {
+		buf[j] = hex[i]
+		if j == 15 || j == 10 || j == 5 {
+			j--
+		}
+		j--
+	}
+	return string(buf[:])
+}
+
 type binBatch struct {
 	reader io.Reader
 	buf    []byte
@@ -0,0 +1,91 @@
+// Package jsondoc implements querying and modifying JSON documents.
+// Documents are decoded to the Go values encoding/json produces, except that numbers are decoded as json.Number, so they are not modified unless they are patched.
+package jsondoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Decode decodes the given JSON text.
+func Decode(b []byte) (any, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, fmt.Errorf("malformed JSON: %w", err)
+	}
+	if d.More() {
+		return nil, errors.New("malformed JSON: unexpected data after the top-level value")
+	}
+	return v, nil
+}
+
+// Encode encodes the given document to JSON text.
+func Encode(doc any) ([]byte, error) {
+	return json.Marshal(doc)
+}
+
+// Equal returns true if the given documents are equal.
+// Numbers are compared by their values, so 1 and 1.0 are equal.
+func Equal(a, b any) bool {
+	switch av := a.(type) {
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			w, ok := bv[k]
+			if !ok || !Equal(v, w) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !Equal(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		x, ok1 := new(big.Float).SetString(av.String())
+		y, ok2 := new(big.Float).SetString(bv.String())
+		if !ok1 || !ok2 {
+			return av == bv
+		}
+		return x.Cmp(y) == 0
+	}
+	return a == b
+}
+
+// clone returns a deep copy of the given document.
+func clone(doc any) any {
+	switch v := doc.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, item := range v {
+			m[k] = clone(item)
+		}
+		return m
+	case []any:
+		s := make([]any, len(v))
+		for i, item := range v {
+			s[i] = clone(item)
+		}
+		return s
+	}
+	return doc
+}
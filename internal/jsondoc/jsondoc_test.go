@@ -0,0 +1,187 @@
+package jsondoc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-commandline-client/internal/jsondoc"
+)
+
+const testDoc = `{"name": "Jane", "age": 42, "address": {"city": "Istanbul", "zip": "34000"}, "tags": ["a", "b", "c"]}`
+
+func TestPath_Find(t *testing.T) {
+	testCases := []struct {
+		path     string
+		target   string
+		definite bool
+	}{
+		{path: "$", target: testDoc, definite: true},
+		{path: "$.name", target: `["Jane"]`, definite: true},
+		{path: "$.address.city", target: `["Istanbul"]`, definite: true},
+		{path: "$['address']['zip']", target: `["34000"]`, definite: true},
+		{path: `$["tags"][1]`, target: `["b"]`, definite: true},
+		{path: "$.tags[-1]", target: `["c"]`, definite: true},
+		{path: "$.tags[*]", target: `["a", "b", "c"]`},
+		{path: "$.address.*", target: `["Istanbul", "34000"]`},
+		{path: "$.missing", target: `[]`, definite: true},
+		{path: "$.tags[5]", target: `[]`, definite: true},
+		{path: "$.name.first", target: `[]`, definite: true},
+	}
+	doc, err := jsondoc.Decode([]byte(testDoc))
+	require.NoError(t, err)
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			p, err := jsondoc.ParsePath(tc.path)
+			require.NoError(t, err)
+			require.Equal(t, tc.definite, p.Definite())
+			vs := p.Find(doc)
+			target, err := jsondoc.Decode([]byte(tc.target))
+			require.NoError(t, err)
+			if tc.path == "$" {
+				require.Len(t, vs, 1)
+				require.True(t, jsondoc.Equal(target, vs[0]))
+				return
+			}
+			if vs == nil {
+				vs = []any{}
+			}
+			require.True(t, jsondoc.Equal(target, vs), "got: %v", vs)
+		})
+	}
+}
+
+func TestParsePath_Error(t *testing.T) {
+	testCases := []struct {
+		path    string
+		errText string
+	}{
+		{path: "name", errText: "invalid JSONPath name: must start with $"},
+		{path: "$..name", errText: "invalid JSONPath $..name: recursive descent is not supported"},
+		{path: "$.tags[x]", errText: "invalid JSONPath $.tags[x]: invalid array index: x"},
+		{path: "$.tags[1", errText: "invalid JSONPath $.tags[1: missing ]"},
+		{path: "$['name", errText: "invalid JSONPath $['name: unterminated member name"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			_, err := jsondoc.ParsePath(tc.path)
+			require.EqualError(t, err, tc.errText)
+		})
+	}
+}
+
+func TestPatch_Apply(t *testing.T) {
+	testCases := []struct {
+		name   string
+		patch  string
+		target string
+	}{
+		{
+			name:   "add member",
+			patch:  `[{"op": "add", "path": "/email", "value": "jane@example.com"}]`,
+			target: `{"name": "Jane", "age": 42, "address": {"city": "Istanbul", "zip": "34000"}, "tags": ["a", "b", "c"], "email": "jane@example.com"}`,
+		},
+		{
+			name:   "add array item",
+			patch:  `[{"op": "add", "path": "/tags/1", "value": "x"}, {"op": "add", "path": "/tags/-", "value": "y"}]`,
+			target: `{"name": "Jane", "age": 42, "address": {"city": "Istanbul", "zip": "34000"}, "tags": ["a", "x", "b", "c", "y"]}`,
+		},
+		{
+			name:   "remove",
+			patch:  `[{"op": "remove", "path": "/address/zip"}, {"op": "remove", "path": "/tags/0"}]`,
+			target: `{"name": "Jane", "age": 42, "address": {"city": "Istanbul"}, "tags": ["b", "c"]}`,
+		},
+		{
+			name:   "replace",
+			patch:  `[{"op": "test", "path": "/age", "value": 42.0}, {"op": "replace", "path": "/address/city", "value": "Ankara"}]`,
+			target: `{"name": "Jane", "age": 42, "address": {"city": "Ankara", "zip": "34000"}, "tags": ["a", "b", "c"]}`,
+		},
+		{
+			name:   "move and copy",
+			patch:  `[{"op": "move", "from": "/address/zip", "path": "/zip"}, {"op": "copy", "from": "/name", "path": "/tags/0"}]`,
+			target: `{"name": "Jane", "age": 42, "address": {"city": "Istanbul"}, "zip": "34000", "tags": ["Jane", "a", "b", "c"]}`,
+		},
+		{
+			name:   "escaped pointer",
+			patch:  `[{"op": "add", "path": "/a~1b~0c", "value": null}]`,
+			target: `{"name": "Jane", "age": 42, "address": {"city": "Istanbul", "zip": "34000"}, "tags": ["a", "b", "c"], "a/b~c": null}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := jsondoc.Decode([]byte(testDoc))
+			require.NoError(t, err)
+			p, err := jsondoc.DecodePatch([]byte(tc.patch))
+			require.NoError(t, err)
+			patched, err := p.Apply(doc)
+			require.NoError(t, err)
+			target, err := jsondoc.Decode([]byte(tc.target))
+			require.NoError(t, err)
+			require.True(t, jsondoc.Equal(target, patched), "got: %v", patched)
+			// the original document is not modified
+			orig, err := jsondoc.Decode([]byte(testDoc))
+			require.NoError(t, err)
+			require.True(t, jsondoc.Equal(orig, doc))
+		})
+	}
+}
+
+func TestPatch_Apply_Error(t *testing.T) {
+	testCases := []struct {
+		name    string
+		patch   string
+		errText string
+	}{
+		{
+			name:    "failed test",
+			patch:   `[{"op": "test", "path": "/name", "value": "John"}]`,
+			errText: "applying JSON Patch operation 0 (test /name): test failed",
+		},
+		{
+			name:    "missing member",
+			patch:   `[{"op": "replace", "path": "/email", "value": "x"}]`,
+			errText: "applying JSON Patch operation 0 (replace /email): member not found: /email",
+		},
+		{
+			name:    "index out of bounds",
+			patch:   `[{"op": "remove", "path": "/tags/3"}]`,
+			errText: "applying JSON Patch operation 0 (remove /tags/3): array index out of bounds: 3",
+		},
+		{
+			name:    "move into child",
+			patch:   `[{"op": "move", "from": "/address", "path": "/address/old"}]`,
+			errText: "applying JSON Patch operation 0 (move /address/old): cannot move a value into one of its children",
+		},
+	}
+	doc, err := jsondoc.Decode([]byte(testDoc))
+	require.NoError(t, err)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := jsondoc.DecodePatch([]byte(tc.patch))
+			require.NoError(t, err)
+			_, err = p.Apply(doc)
+			require.EqualError(t, err, tc.errText)
+		})
+	}
+}
+
+func TestDecodePatch_Error(t *testing.T) {
+	_, err := jsondoc.DecodePatch([]byte(`[{"op": "add", "path": "/a"}]`))
+	require.EqualError(t, err, "malformed JSON Patch: operation 0 (add): value is required")
+	_, err = jsondoc.DecodePatch([]byte(`[{"op": "upsert", "path": "/a"}]`))
+	require.EqualError(t, err, "malformed JSON Patch: operation 0: unknown operation: upsert")
+}
+
+func TestMergePatch(t *testing.T) {
+	doc, err := jsondoc.Decode([]byte(testDoc))
+	require.NoError(t, err)
+	patch, err := jsondoc.Decode([]byte(`{"age": 43, "address": {"zip": null, "country": "TR"}, "tags": ["z"]}`))
+	require.NoError(t, err)
+	merged := jsondoc.MergePatch(doc, patch)
+	target, err := jsondoc.Decode([]byte(`{"name": "Jane", "age": 43, "address": {"city": "Istanbul", "country": "TR"}, "tags": ["z"]}`))
+	require.NoError(t, err)
+	require.True(t, jsondoc.Equal(target, merged), "got: %v", merged)
+	orig, err := jsondoc.Decode([]byte(testDoc))
+	require.NoError(t, err)
+	require.True(t, jsondoc.Equal(orig, doc))
+}
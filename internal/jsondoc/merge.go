@@ -0,0 +1,24 @@
+package jsondoc
+
+// MergePatch applies the given RFC 7396 JSON Merge Patch to the document and returns the merged document.
+// The given document is not modified.
+func MergePatch(doc, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return clone(patch)
+	}
+	target, ok := doc.(map[string]any)
+	if ok {
+		target = clone(target).(map[string]any)
+	} else {
+		target = map[string]any{}
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(target, k)
+			continue
+		}
+		target[k] = MergePatch(target[k], v)
+	}
+	return target
+}
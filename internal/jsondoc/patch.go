@@ -0,0 +1,268 @@
+package jsondoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+	OpMove    = "move"
+	OpCopy    = "copy"
+	OpTest    = "test"
+)
+
+// Operation is an RFC 6902 JSON Patch operation.
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Patch is an RFC 6902 JSON Patch document.
+type Patch []Operation
+
+// DecodePatch decodes the given RFC 6902 JSON Patch document.
+func DecodePatch(b []byte) (Patch, error) {
+	var p Patch
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, fmt.Errorf("malformed JSON Patch: %w", err)
+	}
+	for i, op := range p {
+		switch op.Op {
+		case OpAdd, OpReplace, OpTest:
+			if op.Value == nil {
+				return nil, fmt.Errorf("malformed JSON Patch: operation %d (%s): value is required", i, op.Op)
+			}
+		case OpMove, OpCopy, OpRemove:
+		default:
+			return nil, fmt.Errorf("malformed JSON Patch: operation %d: unknown operation: %s", i, op.Op)
+		}
+	}
+	return p, nil
+}
+
+// Apply applies the patch to the given document and returns the patched document.
+// The given document is not modified.
+// The operations are applied atomically, if one of them fails, an error is returned.
+func (p Patch) Apply(doc any) (any, error) {
+	doc = clone(doc)
+	for i, op := range p {
+		var err error
+		doc, err = op.apply(doc)
+		if err != nil {
+			return nil, fmt.Errorf("applying JSON Patch operation %d (%s %s): %w", i, op.Op, op.Path, err)
+		}
+	}
+	return doc, nil
+}
+
+func (op Operation) apply(doc any) (any, error) {
+	path, err := parsePointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+	switch op.Op {
+	case OpAdd:
+		v, err := Decode(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+	case OpRemove:
+		doc, _, err := remove(doc, path)
+		return doc, err
+	case OpReplace:
+		v, err := Decode(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		doc, _, err = remove(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+	case OpMove:
+		from, err := parsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		if len(path) > len(from) && strings.HasPrefix(op.Path, op.From+"/") {
+			return nil, errors.New("cannot move a value into one of its children")
+		}
+		doc, v, err := remove(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+	case OpCopy:
+		from, err := parsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		v, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, clone(v))
+	case OpTest:
+		want, err := Decode(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		v, err := get(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !Equal(v, want) {
+			return nil, errors.New("test failed")
+		}
+		return doc, nil
+	}
+	return nil, fmt.Errorf("unknown operation: %s", op.Op)
+}
+
+// parsePointer parses the given RFC 6901 JSON Pointer.
+func parsePointer(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(s, "/") {
+		return nil, fmt.Errorf("invalid JSON Pointer %s: must start with /", s)
+	}
+	tokens := strings.Split(s[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func get(doc any, path []string) (any, error) {
+	for i, t := range path {
+		switch v := doc.(type) {
+		case map[string]any:
+			item, ok := v[t]
+			if !ok {
+				return nil, fmt.Errorf("member not found: %s", pointerText(path[:i+1]))
+			}
+			doc = item
+		case []any:
+			idx, err := arrayIndex(t, len(v), false)
+			if err != nil {
+				return nil, err
+			}
+			doc = v[idx]
+		default:
+			return nil, fmt.Errorf("not a container: %s", pointerText(path[:i]))
+		}
+	}
+	return doc, nil
+}
+
+func add(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parent, err := get(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := path[len(path)-1]
+	switch v := parent.(type) {
+	case map[string]any:
+		v[last] = value
+		return doc, nil
+	case []any:
+		idx := len(v)
+		if last != "-" {
+			idx, err = arrayIndex(last, len(v), true)
+			if err != nil {
+				return nil, err
+			}
+		}
+		s := append(v[:idx:idx], value)
+		s = append(s, v[idx:]...)
+		return set(doc, path[:len(path)-1], s)
+	}
+	return nil, fmt.Errorf("not a container: %s", pointerText(path[:len(path)-1]))
+}
+
+func remove(doc any, path []string) (any, any, error) {
+	if len(path) == 0 {
+		return nil, doc, nil
+	}
+	parent, err := get(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+	last := path[len(path)-1]
+	switch v := parent.(type) {
+	case map[string]any:
+		item, ok := v[last]
+		if !ok {
+			return nil, nil, fmt.Errorf("member not found: %s", pointerText(path))
+		}
+		delete(v, last)
+		return doc, item, nil
+	case []any:
+		idx, err := arrayIndex(last, len(v), false)
+		if err != nil {
+			return nil, nil, err
+		}
+		item := v[idx]
+		s := append(v[:idx:idx], v[idx+1:]...)
+		doc, err = set(doc, path[:len(path)-1], s)
+		return doc, item, err
+	}
+	return nil, nil, fmt.Errorf("not a container: %s", pointerText(path[:len(path)-1]))
+}
+
+// set replaces the value at the given path, which must exist.
+// It is used to replace arrays, since their length changes when an item is added or removed.
+func set(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parent, err := get(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := path[len(path)-1]
+	switch v := parent.(type) {
+	case map[string]any:
+		v[last] = value
+	case []any:
+		idx, err := arrayIndex(last, len(v), false)
+		if err != nil {
+			return nil, err
+		}
+		v[idx] = value
+	}
+	return doc, nil
+}
+
+func arrayIndex(token string, size int, allowEnd bool) (int, error) {
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index: %s", token)
+	}
+	if idx > size || (idx == size && !allowEnd) {
+		return 0, fmt.Errorf("array index out of bounds: %d", idx)
+	}
+	return idx, nil
+}
+
+func pointerText(path []string) string {
+	var sb strings.Builder
+	for _, t := range path {
+		sb.WriteByte('/')
+		sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(t, "~", "~0"), "/", "~1"))
+	}
+	return sb.String()
+}
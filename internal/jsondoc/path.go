@@ -0,0 +1,158 @@
+package jsondoc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+type pathSegment struct {
+	name     string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// Path is a JSONPath expression.
+// The supported subset consists of the root ($), member names (.name or ['name']), array indexes ([0], negative indexes count from the end) and wildcards (.* or [*]).
+type Path struct {
+	text     string
+	segments []pathSegment
+}
+
+// ParsePath parses the given JSONPath expression.
+func ParsePath(text string) (Path, error) {
+	p := Path{text: text}
+	s := strings.TrimSpace(text)
+	if !strings.HasPrefix(s, "$") {
+		return p, fmt.Errorf("invalid JSONPath %s: must start with $", text)
+	}
+	s = s[1:]
+	for s != "" {
+		var seg pathSegment
+		var err error
+		switch s[0] {
+		case '.':
+			seg, s, err = parseDotSegment(s[1:])
+		case '[':
+			seg, s, err = parseBracketSegment(s[1:])
+		default:
+			err = fmt.Errorf("unexpected character: %c", s[0])
+		}
+		if err != nil {
+			return p, fmt.Errorf("invalid JSONPath %s: %w", text, err)
+		}
+		p.segments = append(p.segments, seg)
+	}
+	return p, nil
+}
+
+func parseDotSegment(s string) (pathSegment, string, error) {
+	if strings.HasPrefix(s, ".") {
+		return pathSegment{}, "", fmt.Errorf("recursive descent is not supported")
+	}
+	end := strings.IndexAny(s, ".[")
+	if end < 0 {
+		end = len(s)
+	}
+	name := s[:end]
+	if name == "" {
+		return pathSegment{}, "", fmt.Errorf("member name is required after .")
+	}
+	if name == "*" {
+		return pathSegment{wildcard: true}, s[end:], nil
+	}
+	return pathSegment{name: name}, s[end:], nil
+}
+
+func parseBracketSegment(s string) (pathSegment, string, error) {
+	if s != "" && (s[0] == '\'' || s[0] == '"') {
+		q := s[0]
+		end := strings.IndexByte(s[1:], q)
+		if end < 0 || !strings.HasPrefix(s[end+2:], "]") {
+			return pathSegment{}, "", fmt.Errorf("unterminated member name")
+		}
+		return pathSegment{name: s[1 : end+1]}, s[end+3:], nil
+	}
+	end := strings.IndexByte(s, ']')
+	if end < 0 {
+		return pathSegment{}, "", fmt.Errorf("missing ]")
+	}
+	inner := strings.TrimSpace(s[:end])
+	if inner == "*" {
+		return pathSegment{wildcard: true}, s[end+1:], nil
+	}
+	idx, err := strconv.Atoi(inner)
+	if err != nil {
+		return pathSegment{}, "", fmt.Errorf("invalid array index: %s", inner)
+	}
+	return pathSegment{index: idx, isIndex: true}, s[end+1:], nil
+}
+
+// String returns the text of the path.
+func (p Path) String() string {
+	return p.text
+}
+
+// Definite returns true if the path matches at most one value.
+func (p Path) Definite() bool {
+	for _, seg := range p.segments {
+		if seg.wildcard {
+			return false
+		}
+	}
+	return true
+}
+
+// Find returns the values matching the path in the given document.
+// Returns no values if nothing matches.
+func (p Path) Find(doc any) []any {
+	vs := []any{doc}
+	for _, seg := range p.segments {
+		var next []any
+		for _, v := range vs {
+			next = append(next, seg.find(v)...)
+		}
+		vs = next
+	}
+	return vs
+}
+
+func (seg pathSegment) find(v any) []any {
+	switch vv := v.(type) {
+	case map[string]any:
+		if seg.wildcard {
+			keys := maps.Keys(vv)
+			slices.Sort(keys)
+			vs := make([]any, len(keys))
+			for i, k := range keys {
+				vs[i] = vv[k]
+			}
+			return vs
+		}
+		if seg.isIndex {
+			return nil
+		}
+		if item, ok := vv[seg.name]; ok {
+			return []any{item}
+		}
+	case []any:
+		if seg.wildcard {
+			return vv
+		}
+		if !seg.isIndex {
+			return nil
+		}
+		idx := seg.index
+		if idx < 0 {
+			idx += len(vv)
+		}
+		if idx >= 0 && idx < len(vv) {
+			return []any{vv[idx]}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Package mapping contains the helpers to work with the SQL mappings of Maps.
+package mapping
+
+import (
+	"context"
+	"math"
+
+	"github.com/hazelcast/hazelcast-go-client"
+
+	"github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec"
+)
+
+const (
+	TypeIMap                   = "IMap"
+	FormatJava                 = "java"
+	FormatJSON                 = "json"
+	FormatJSONFlat             = "json-flat"
+	FormatCompact              = "compact"
+	FormatPortable             = "portable"
+	OptionFormat               = "Format"
+	OptionJavaClass            = "JavaClass"
+	OptionCompactTypeName      = "CompactTypeName"
+	OptionPortableFactoryID    = "PortableFactoryId"
+	OptionPortableClassID      = "PortableClassId"
+	OptionPortableClassVersion = "PortableClassVersion"
+	// OptionKeyPrefix and OptionValuePrefix are prepended to the options for the key and the value, e.g., keyFormat
+	OptionKeyPrefix   = "key"
+	OptionValuePrefix = "value"
+)
+
+// SampleEntries returns the keys and the values of up to count entries from the given Map.
+// The entries are fetched starting from the first partition, until count entries are fetched.
+func SampleEntries(ctx context.Context, ci *hazelcast.ClientInternal, mapName string, count int) (keys, values []hazelcast.Data, err error) {
+	for pid := int32(0); pid < ci.PartitionCount() && len(keys) < count; pid++ {
+		// the initial pointer starts the iteration from the beginning of the partition
+		pointers := []hazelcast.Pair{hazelcast.NewPair(int32(math.MaxInt32), int32(-1))}
+		req := codec.EncodeMapFetchEntriesRequest(mapName, pointers, int32(count-len(keys)))
+		resp, err := ci.InvokeOnPartition(ctx, req, pid, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, entries := codec.DecodeMapFetchEntriesResponse(resp)
+		for _, e := range entries {
+			keys = append(keys, e.Key.(hazelcast.Data))
+			values = append(values, e.Value.(hazelcast.Data))
+		}
+	}
+	return keys, values, nil
+}
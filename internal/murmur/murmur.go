@@ -0,0 +1,78 @@
+// Package murmur contains the Murmur3 hash functions used by the Hazelcast members.
+package murmur
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const defaultSeed = 0x01000193
+
+// Hash64 returns the same value as com.hazelcast.internal.util.HashUtil.MurmurHash3_x64_64 with the default seed.
+// The value is used by the members to place an item in a CardinalityEstimator.
+func Hash64(data []byte) int64 {
+	return hash64(data, defaultSeed)
+}
+
+func hash64(data []byte, seed int32) int64 {
+	var (
+		s  = uint64(int64(seed))
+		h1 = 0x9368e53c2f6af274 ^ s
+		h2 = 0x586dcd208f7cd3fd ^ s
+		c1 = uint64(0x87c37b91114253d5)
+		c2 = uint64(0x4cf5ad432745937f)
+	)
+	mix := func(k1, k2 uint64) {
+		k1 *= c1
+		k1 = bits.RotateLeft64(k1, 23)
+		k1 *= c2
+		h1 ^= k1
+		h1 += h2
+		h2 = bits.RotateLeft64(h2, 41)
+		k2 *= c2
+		k2 = bits.RotateLeft64(k2, 23)
+		k2 *= c1
+		h2 ^= k2
+		h2 += h1
+		h1 = h1*3 + 0x52dce729
+		h2 = h2*3 + 0x38495ab5
+		c1 = c1*5 + 0x7b7d159c
+		c2 = c2*5 + 0x6bce6396
+	}
+	ln := len(data)
+	blocks := ln / 16
+	for i := 0; i < blocks; i++ {
+		k1 := binary.LittleEndian.Uint64(data[i*16:])
+		k2 := binary.LittleEndian.Uint64(data[i*16+8:])
+		mix(k1, k2)
+	}
+	if rem := ln & 15; rem > 0 {
+		tail := data[blocks*16:]
+		var k1, k2 uint64
+		for i := rem - 1; i >= 0; i-- {
+			// bytes are sign extended as in Java
+			b := uint64(int64(int8(tail[i])))
+			if i >= 8 {
+				k2 ^= b << (8 * (i - 8))
+			} else {
+				k1 ^= b << (8 * i)
+			}
+		}
+		mix(k1, k2)
+	}
+	h2 ^= uint64(ln)
+	h1 += h2
+	h2 += h1
+	h1 = fmix64(h1)
+	h2 = fmix64(h2)
+	return int64(h1 + h2)
+}
+
+func fmix64(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+	return k
+}
@@ -0,0 +1,77 @@
+package murmur
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The targets are the values of com.hazelcast.internal.util.HashUtil.MurmurHash3_x64_64 with the default seed.
+func TestHash64(t *testing.T) {
+	testCases := []struct {
+		name   string
+		data   []byte
+		target int64
+	}{
+		{name: "0 bytes", data: sequence(0), target: 9168145165656307917},
+		{name: "1 bytes", data: sequence(1), target: 5752267848377978175},
+		{name: "2 bytes", data: sequence(2), target: 2018941596718178118},
+		{name: "3 bytes", data: sequence(3), target: 8195339869324187135},
+		{name: "4 bytes", data: sequence(4), target: 4320756845542245430},
+		{name: "5 bytes", data: sequence(5), target: 7925048649906425281},
+		{name: "6 bytes", data: sequence(6), target: -8985137148821558423},
+		{name: "7 bytes", data: sequence(7), target: -7064257043272679407},
+		{name: "8 bytes", data: sequence(8), target: -1902120904038518238},
+		{name: "9 bytes", data: sequence(9), target: -8384773992223957056},
+		{name: "10 bytes", data: sequence(10), target: 4360641137417236979},
+		{name: "11 bytes", data: sequence(11), target: -5392800252467988908},
+		{name: "12 bytes", data: sequence(12), target: 7317622029525643883},
+		{name: "13 bytes", data: sequence(13), target: 1796701068431368383},
+		{name: "14 bytes", data: sequence(14), target: -6328968190018906218},
+		{name: "15 bytes", data: sequence(15), target: 6917234693984741713},
+		{name: "16 bytes", data: sequence(16), target: 2462466348024822273},
+		{name: "31 bytes", data: sequence(31), target: 2703501828004338677},
+		{name: "33 bytes", data: sequence(33), target: 473499333763100096},
+		{name: "1 negative bytes", data: negative(1), target: -6508478005855803180},
+		{name: "2 negative bytes", data: negative(2), target: 5041222527636707953},
+		{name: "3 negative bytes", data: negative(3), target: -5508755335403096003},
+		{name: "4 negative bytes", data: negative(4), target: 4796947563831523594},
+		{name: "5 negative bytes", data: negative(5), target: 3048671564684161541},
+		{name: "6 negative bytes", data: negative(6), target: 1514324888723678687},
+		{name: "7 negative bytes", data: negative(7), target: 3285000881176650139},
+		{name: "8 negative bytes", data: negative(8), target: -4828292063628352589},
+		{name: "9 negative bytes", data: negative(9), target: 1029762905853606014},
+		{name: "10 negative bytes", data: negative(10), target: -8400262956517578121},
+		{name: "11 negative bytes", data: negative(11), target: -4400342098693521899},
+		{name: "12 negative bytes", data: negative(12), target: 1166875584705674936},
+		{name: "13 negative bytes", data: negative(13), target: -8893832002128566078},
+		{name: "14 negative bytes", data: negative(14), target: -2168859225407556627},
+		{name: "15 negative bytes", data: negative(15), target: 2327844313187308038},
+		{name: "16 negative bytes", data: negative(16), target: 3772013005435038353},
+		{name: "string", data: []byte("hazelcast"), target: -5410766897535987873},
+		{name: "long string", data: []byte("The quick brown fox jumps over the lazy dog"), target: -6294855256513888441},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.target, Hash64(tc.data))
+		})
+	}
+}
+
+// sequence returns the bytes 0, 1, ..., n-1.
+func sequence(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+// negative returns n bytes starting from 0x80, which are negative in Java.
+func negative(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(0x80 + i*7)
+	}
+	return b
+}
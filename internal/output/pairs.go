@@ -6,16 +6,16 @@ import (
 	"github.com/hazelcast/hazelcast-commandline-client/internal/serialization"
 )
 
-func DecodePairs(ic *hazelcast.ClientInternal, pairs []hazelcast.Pair, showType bool) []Row {
+func DecodePairs(ic *hazelcast.ClientInternal, pairs []hazelcast.Pair, showType, raw bool) []Row {
 	rows := make([]Row, 0, len(pairs))
 	for _, pair := range pairs {
 		row := make(Row, 0, 4)
-		kt, key := ensureTypeValue(ic, pair.Key.(hazelcast.Data))
+		kt, key := ensureTypeValue(ic, pair.Key.(hazelcast.Data), raw)
 		row = append(row, NewKeyColumn(kt, key))
 		if showType {
 			row = append(row, NewKeyTypeColumn(kt))
 		}
-		vt, value := ensureTypeValue(ic, pair.Value.(hazelcast.Data))
+		vt, value := ensureTypeValue(ic, pair.Value.(hazelcast.Data), raw)
 		row = append(row, NewValueColumn(vt, value))
 		if showType {
 			row = append(row, NewValueTypeColumn(vt))
@@ -25,11 +25,11 @@ func DecodePairs(ic *hazelcast.ClientInternal, pairs []hazelcast.Pair, showType
 	return rows
 }
 
-func ensureTypeValue(ic *hazelcast.ClientInternal, data hazelcast.Data) (int32, any) {
+func ensureTypeValue(ic *hazelcast.ClientInternal, data hazelcast.Data, raw bool) (int32, any) {
 	t := data.Type()
 	v, err := ic.DecodeData(data)
 	if err != nil {
-		v = serialization.NondecodedType(serialization.TypeToLabel(t))
+		v = serialization.NondecodedValue(data, raw)
 	}
 	return t, v
 }
@@ -27,6 +27,7 @@ type InitContext interface {
 	AddIntFlag(long, short string, value int64, required bool, help string)
 	AddStringConfig(name, value, flag string, help string)
 	AddStringFlag(long, short, value string, required bool, help string)
+	AddStringSliceFlag(long, short string, required bool, help string)
 	AddStringArg(key, title string)
 	AddStringSliceArg(key, title string, min, max int)
 	AddKeyValueSliceArg(key, title string, min, max int)
@@ -37,8 +38,13 @@ type InitContext interface {
 	SetCommandHelp(long, short string)
 	SetCommandUsage(usage string)
 	SetTopLevel(b bool)
+	SetFlagCompletion(flag string, f CompletionFunc)
+	SetArgCompletion(f CompletionFunc)
 }
 
+// CompletionFunc returns the suggestions for a flag value or an argument in shell completion.
+type CompletionFunc func(ctx context.Context, ec ExecContext) ([]string, error)
+
 type ExecContext interface {
 	AddOutputRows(ctx context.Context, rows ...output.Row) error
 	AddOutputStream(ctx context.Context, ch <-chan output.Row) error
@@ -101,7 +101,7 @@ func sortedItems[T any](d map[string]T) RegistryItems[T] {
 	return r
 }
 
-var cmdRegex = MustValue(regexp.Compile(`^[a-z][a-z-]+(:[a-z-]+)?$`))
+var cmdRegex = MustValue(regexp.Compile(`^[a-z][a-z-]+(:[a-z-]+)*$`))
 
 func validName(name string) bool {
 	return cmdRegex.Match([]byte(name))
@@ -18,6 +18,8 @@ func TestValidateName(t *testing.T) {
 		{name: "map:get", valid: true},
 		{name: "Map:get", valid: false},
 		{name: "map:get2", valid: false},
+		{name: "sql:migrate:status", valid: true},
+		{name: "sql:migrate:", valid: false},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
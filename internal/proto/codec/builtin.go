@@ -286,3 +286,90 @@ func DecodeNullableForBitmapIndexOptions(frameIterator *proto.ForwardFrameIterat
 	}
 	return DecodeBitmapIndexOptions(frameIterator)
 }
+
+func EncodeEntryListIntegerInteger(message *proto.ClientMessage, entries []proto.Pair) {
+	content := make([]byte, len(entries)*2*proto.IntSizeInBytes)
+	for i, e := range entries {
+		offset := int32(i * 2 * proto.IntSizeInBytes)
+		EncodeInt(content, offset, e.Key.(int32))
+		EncodeInt(content, offset+proto.IntSizeInBytes, e.Value.(int32))
+	}
+	message.AddFrame(proto.NewFrame(content))
+}
+
+func DecodeEntryListIntegerInteger(frameIterator *proto.ForwardFrameIterator) []proto.Pair {
+	content := frameIterator.Next().Content
+	n := len(content) / (2 * proto.IntSizeInBytes)
+	result := make([]proto.Pair, n)
+	for i := 0; i < n; i++ {
+		offset := int32(i * 2 * proto.IntSizeInBytes)
+		key := DecodeInt(content, offset)
+		value := DecodeInt(content, offset+proto.IntSizeInBytes)
+		result[i] = proto.NewPair(key, value)
+	}
+	return result
+}
+
+// EncodeFrames adds the given frames to the message as is.
+func EncodeFrames(message *proto.ClientMessage, frames []proto.Frame) {
+	for _, f := range frames {
+		message.AddFrame(f.Copy())
+	}
+}
+
+// DecodeNullableFrames returns the frames of the next data structure without decoding them.
+// Returns nil if the next frame is a null frame.
+func DecodeNullableFrames(frameIterator *proto.ForwardFrameIterator) []proto.Frame {
+	if NextFrameIsNullFrame(frameIterator) {
+		return nil
+	}
+	frame := frameIterator.Next()
+	frames := []proto.Frame{frame}
+	if !frame.IsBeginFrame() {
+		return frames
+	}
+	expectedEndFrames := 1
+	for expectedEndFrames != 0 {
+		frame = frameIterator.Next()
+		if frame.IsEndFrame() {
+			expectedEndFrames--
+		} else if frame.IsBeginFrame() {
+			expectedEndFrames++
+		}
+		frames = append(frames, frame)
+	}
+	return frames
+}
+
+func DecodeLongArray(frameIterator *proto.ForwardFrameIterator) []int64 {
+	frame := frameIterator.Next()
+	itemCount := len(frame.Content) / proto.LongSizeInBytes
+	result := make([]int64, itemCount)
+	for i := 0; i < itemCount; i++ {
+		result[i] = DecodeLong(frame.Content, int32(i*proto.LongSizeInBytes))
+	}
+	return result
+}
+
+func DecodeNullableForLongArray(frameIterator *proto.ForwardFrameIterator) []int64 {
+	if NextFrameIsNullFrame(frameIterator) {
+		return nil
+	}
+	return DecodeLongArray(frameIterator)
+}
+
+func EncodeListMultiFrameForFieldDescriptor(message *proto.ClientMessage, fields []control.FieldDescriptor) {
+	message.AddFrame(NewBeginFrame())
+	for i := 0; i < len(fields); i++ {
+		EncodeFieldDescriptor(message, fields[i])
+	}
+	message.AddFrame(NewEndFrame())
+}
+
+func EncodeListMultiFrameForSchema(message *proto.ClientMessage, schemas []control.Schema) {
+	message.AddFrame(NewBeginFrame())
+	for i := 0; i < len(schemas); i++ {
+		EncodeSchema(message, schemas[i])
+	}
+	message.AddFrame(NewEndFrame())
+}
@@ -0,0 +1,74 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	iserialization "github.com/hazelcast/hazelcast-go-client"
+	proto "github.com/hazelcast/hazelcast-go-client"
+)
+
+const (
+	CacheEventJournalReadCodecRequestMessageType  = int32(0x132000)
+	CacheEventJournalReadCodecResponseMessageType = int32(0x132001)
+
+	CacheEventJournalReadCodecRequestStartSequenceOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheEventJournalReadCodecRequestMinSizeOffset       = CacheEventJournalReadCodecRequestStartSequenceOffset + proto.LongSizeInBytes
+	CacheEventJournalReadCodecRequestMaxSizeOffset       = CacheEventJournalReadCodecRequestMinSizeOffset + proto.IntSizeInBytes
+	CacheEventJournalReadCodecRequestInitialFrameSize    = CacheEventJournalReadCodecRequestMaxSizeOffset + proto.IntSizeInBytes
+	CacheEventJournalReadResponseReadCountOffset         = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+	CacheEventJournalReadResponseNextSeqOffset           = CacheEventJournalReadResponseReadCountOffset + proto.IntSizeInBytes
+)
+
+// Reads from the cache event journal in batches. You may specify the start sequence,
+// the minimum required number of items in the response, the maximum number of items
+// in the response, a predicate that the events should pass and a projection to
+// apply to the events in the journal.
+// If the event journal currently contains less events than {@code minSize}, the
+// call will wait until it has sufficient items.
+// The predicate, filter and projection may be {@code null} in which case all elements are returned
+// and no projection is applied.
+
+func EncodeCacheEventJournalReadRequest(name string, startSequence int64, minSize int32, maxSize int32, predicate iserialization.Data, projection iserialization.Data) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheEventJournalReadCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	EncodeLong(initialFrame.Content, CacheEventJournalReadCodecRequestStartSequenceOffset, startSequence)
+	EncodeInt(initialFrame.Content, CacheEventJournalReadCodecRequestMinSizeOffset, minSize)
+	EncodeInt(initialFrame.Content, CacheEventJournalReadCodecRequestMaxSizeOffset, maxSize)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheEventJournalReadCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeNullableForData(clientMessage, predicate)
+	EncodeNullableForData(clientMessage, projection)
+
+	return clientMessage
+}
+
+func DecodeCacheEventJournalReadResponse(clientMessage *proto.ClientMessage) (readCount int32, nextSeq int64, items []*iserialization.Data, itemSeqs []int64) {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	readCount = DecodeInt(initialFrame.Content, CacheEventJournalReadResponseReadCountOffset)
+	nextSeq = DecodeLong(initialFrame.Content, CacheEventJournalReadResponseNextSeqOffset)
+	items = DecodeListMultiFrameForData(frameIterator)
+	itemSeqs = DecodeNullableForLongArray(frameIterator)
+
+	return readCount, nextSeq, items, itemSeqs
+}
@@ -0,0 +1,58 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	proto "github.com/hazelcast/hazelcast-go-client"
+)
+
+const (
+	CacheEventJournalSubscribeCodecRequestMessageType  = int32(0x131F00)
+	CacheEventJournalSubscribeCodecResponseMessageType = int32(0x131F01)
+
+	CacheEventJournalSubscribeCodecRequestInitialFrameSize = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheEventJournalSubscribeResponseOldestSequenceOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+	CacheEventJournalSubscribeResponseNewestSequenceOffset = CacheEventJournalSubscribeResponseOldestSequenceOffset + proto.LongSizeInBytes
+)
+
+// Performs the initial subscription to the cache event journal.
+// This includes retrieving the event journal sequences of the
+// oldest and newest event in the journal.
+
+func EncodeCacheEventJournalSubscribeRequest(name string) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheEventJournalSubscribeCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheEventJournalSubscribeCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeCacheEventJournalSubscribeResponse(clientMessage *proto.ClientMessage) (oldestSequence int64, newestSequence int64) {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	oldestSequence = DecodeLong(initialFrame.Content, CacheEventJournalSubscribeResponseOldestSequenceOffset)
+	newestSequence = DecodeLong(initialFrame.Content, CacheEventJournalSubscribeResponseNewestSequenceOffset)
+
+	return oldestSequence, newestSequence
+}
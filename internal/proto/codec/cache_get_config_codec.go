@@ -0,0 +1,54 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	proto "github.com/hazelcast/hazelcast-go-client"
+)
+
+const (
+	CacheGetConfigCodecRequestMessageType  = int32(0x130C00)
+	CacheGetConfigCodecResponseMessageType = int32(0x130C01)
+
+	CacheGetConfigCodecRequestInitialFrameSize = proto.PartitionIDOffset + proto.IntSizeInBytes
+)
+
+// Returns the cache configuration with the given name.
+// The configuration is returned as raw frames, so it can be passed to EncodeCacheCreateConfigRequest as is.
+
+func EncodeCacheGetConfigRequest(name string, simpleName string) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheGetConfigCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheGetConfigCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeString(clientMessage, simpleName)
+
+	return clientMessage
+}
+
+func DecodeCacheGetConfigResponse(clientMessage *proto.ClientMessage) []proto.Frame {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return DecodeNullableFrames(frameIterator)
+}
@@ -0,0 +1,58 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	proto "github.com/hazelcast/hazelcast-go-client"
+)
+
+const (
+	CacheIterateEntriesCodecRequestMessageType  = int32(0x131C00)
+	CacheIterateEntriesCodecResponseMessageType = int32(0x131C01)
+
+	CacheIterateEntriesCodecRequestBatchOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheIterateEntriesCodecRequestInitialFrameSize = CacheIterateEntriesCodecRequestBatchOffset + proto.IntSizeInBytes
+)
+
+// Fetches specified number of entries from the specified partition starting from specified table index.
+
+func EncodeCacheIterateEntriesRequest(name string, iterationPointers []proto.Pair, batch int32) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheIterateEntriesCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	EncodeInt(initialFrame.Content, CacheIterateEntriesCodecRequestBatchOffset, batch)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheIterateEntriesCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeEntryListIntegerInteger(clientMessage, iterationPointers)
+
+	return clientMessage
+}
+
+func DecodeCacheIterateEntriesResponse(clientMessage *proto.ClientMessage) (iterationPointers []proto.Pair, entries []proto.Pair) {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	iterationPointers = DecodeEntryListIntegerInteger(frameIterator)
+	entries = DecodeEntryListForDataAndData(frameIterator)
+
+	return iterationPointers, entries
+}
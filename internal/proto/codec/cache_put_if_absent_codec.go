@@ -0,0 +1,59 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	iserialization "github.com/hazelcast/hazelcast-go-client"
+	proto "github.com/hazelcast/hazelcast-go-client"
+)
+
+const (
+	CachePutIfAbsentCodecRequestMessageType  = int32(0x131200)
+	CachePutIfAbsentCodecResponseMessageType = int32(0x131201)
+
+	CachePutIfAbsentCodecRequestCompletionIdOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CachePutIfAbsentCodecRequestInitialFrameSize   = CachePutIfAbsentCodecRequestCompletionIdOffset + proto.IntSizeInBytes
+	CachePutIfAbsentResponseResponseOffset         = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Associates the specified key with the given value if and only if there is not yet a mapping defined for the
+// specified key.
+
+func EncodeCachePutIfAbsentRequest(name string, key iserialization.Data, value iserialization.Data, expiryPolicy iserialization.Data, completionId int32) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CachePutIfAbsentCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	EncodeInt(initialFrame.Content, CachePutIfAbsentCodecRequestCompletionIdOffset, completionId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CachePutIfAbsentCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, key)
+	EncodeData(clientMessage, value)
+	EncodeNullableForData(clientMessage, expiryPolicy)
+
+	return clientMessage
+}
+
+func DecodeCachePutIfAbsentResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return DecodeBoolean(initialFrame.Content, CachePutIfAbsentResponseResponseOffset)
+}
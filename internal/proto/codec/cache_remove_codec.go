@@ -0,0 +1,58 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	iserialization "github.com/hazelcast/hazelcast-go-client"
+	proto "github.com/hazelcast/hazelcast-go-client"
+)
+
+const (
+	CacheRemoveCodecRequestMessageType  = int32(0x131600)
+	CacheRemoveCodecResponseMessageType = int32(0x131601)
+
+	CacheRemoveCodecRequestCompletionIdOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheRemoveCodecRequestInitialFrameSize   = CacheRemoveCodecRequestCompletionIdOffset + proto.IntSizeInBytes
+	CacheRemoveResponseResponseOffset         = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Atomically removes the mapping for a key only if currently mapped to the given value.
+// If currentValue is nil, the mapping is removed unconditionally.
+
+func EncodeCacheRemoveRequest(name string, key iserialization.Data, currentValue iserialization.Data, completionId int32) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheRemoveCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	EncodeInt(initialFrame.Content, CacheRemoveCodecRequestCompletionIdOffset, completionId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheRemoveCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, key)
+	EncodeNullableForData(clientMessage, currentValue)
+
+	return clientMessage
+}
+
+func DecodeCacheRemoveResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return DecodeBoolean(initialFrame.Content, CacheRemoveResponseResponseOffset)
+}
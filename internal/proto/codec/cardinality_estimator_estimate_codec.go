@@ -0,0 +1,53 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	proto "github.com/hazelcast/hazelcast-go-client"
+)
+
+const (
+	CardinalityEstimatorEstimateCodecRequestMessageType  = int32(0x1B0200)
+	CardinalityEstimatorEstimateCodecResponseMessageType = int32(0x1B0201)
+
+	CardinalityEstimatorEstimateCodecRequestInitialFrameSize = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CardinalityEstimatorEstimateResponseResponseOffset       = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Estimates the cardinality of the aggregation so far.
+// If it was previously estimated and never invalidated, then the cached version is used.
+
+func EncodeCardinalityEstimatorEstimateRequest(name string) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CardinalityEstimatorEstimateCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CardinalityEstimatorEstimateCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeCardinalityEstimatorEstimateResponse(clientMessage *proto.ClientMessage) int64 {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return DecodeLong(initialFrame.Content, CardinalityEstimatorEstimateResponseResponseOffset)
+}
@@ -0,0 +1,45 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	proto "github.com/hazelcast/hazelcast-go-client"
+
+	pubcontrol "github.com/hazelcast/hazelcast-commandline-client/internal/proto/codec/control"
+)
+
+const (
+	ClientSendAllSchemasCodecRequestMessageType = int32(0x001500)
+
+	ClientSendAllSchemasCodecRequestInitialFrameSize = proto.PartitionIDOffset + proto.IntSizeInBytes
+)
+
+// Sends all the schemas to the cluster.
+
+func EncodeClientSendAllSchemasRequest(schemas []pubcontrol.Schema) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ClientSendAllSchemasCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ClientSendAllSchemasCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeListMultiFrameForSchema(clientMessage, schemas)
+
+	return clientMessage
+}
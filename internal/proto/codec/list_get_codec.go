@@ -0,0 +1,55 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	iserialization "github.com/hazelcast/hazelcast-go-client"
+	proto "github.com/hazelcast/hazelcast-go-client"
+)
+
+const (
+	ListGetCodecRequestMessageType  = int32(0x050F00)
+	ListGetCodecResponseMessageType = int32(0x050F01)
+
+	ListGetCodecRequestIndexOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ListGetCodecRequestInitialFrameSize = ListGetCodecRequestIndexOffset + proto.IntSizeInBytes
+)
+
+// Returns the element at the specified position in this list
+
+func EncodeListGetRequest(name string, index int32) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ListGetCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	EncodeInt(initialFrame.Content, ListGetCodecRequestIndexOffset, index)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ListGetCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeListGetResponse(clientMessage *proto.ClientMessage) iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return DecodeNullableForData(frameIterator)
+}
@@ -0,0 +1,58 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	iserialization "github.com/hazelcast/hazelcast-go-client"
+	proto "github.com/hazelcast/hazelcast-go-client"
+)
+
+const (
+	ListSubCodecRequestMessageType  = int32(0x051500)
+	ListSubCodecResponseMessageType = int32(0x051501)
+
+	ListSubCodecRequestFromOffset       = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ListSubCodecRequestToOffset         = ListSubCodecRequestFromOffset + proto.IntSizeInBytes
+	ListSubCodecRequestInitialFrameSize = ListSubCodecRequestToOffset + proto.IntSizeInBytes
+)
+
+// Returns a view of the portion of this list between the specified from, inclusive, and to, exclusive.(If from and
+// to are equal, the returned list is empty.)
+
+func EncodeListSubRequest(name string, from int32, to int32) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ListSubCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	EncodeInt(initialFrame.Content, ListSubCodecRequestFromOffset, from)
+	EncodeInt(initialFrame.Content, ListSubCodecRequestToOffset, to)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ListSubCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeListSubResponse(clientMessage *proto.ClientMessage) []*iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return DecodeListMultiFrameForData(frameIterator)
+}
@@ -0,0 +1,74 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	iserialization "github.com/hazelcast/hazelcast-go-client"
+	proto "github.com/hazelcast/hazelcast-go-client"
+)
+
+const (
+	MapEventJournalReadCodecRequestMessageType  = int32(0x014200)
+	MapEventJournalReadCodecResponseMessageType = int32(0x014201)
+
+	MapEventJournalReadCodecRequestStartSequenceOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	MapEventJournalReadCodecRequestMinSizeOffset       = MapEventJournalReadCodecRequestStartSequenceOffset + proto.LongSizeInBytes
+	MapEventJournalReadCodecRequestMaxSizeOffset       = MapEventJournalReadCodecRequestMinSizeOffset + proto.IntSizeInBytes
+	MapEventJournalReadCodecRequestInitialFrameSize    = MapEventJournalReadCodecRequestMaxSizeOffset + proto.IntSizeInBytes
+	MapEventJournalReadResponseReadCountOffset         = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+	MapEventJournalReadResponseNextSeqOffset           = MapEventJournalReadResponseReadCountOffset + proto.IntSizeInBytes
+)
+
+// Reads from the map event journal in batches. You may specify the start sequence,
+// the minimum required number of items in the response, the maximum number of items
+// in the response, a predicate that the events should pass and a projection to
+// apply to the events in the journal.
+// If the event journal currently contains less events than {@code minSize}, the
+// call will wait until it has sufficient items.
+// The predicate, filter and projection may be {@code null} in which case all elements are returned
+// and no projection is applied.
+
+func EncodeMapEventJournalReadRequest(name string, startSequence int64, minSize int32, maxSize int32, predicate iserialization.Data, projection iserialization.Data) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, MapEventJournalReadCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	EncodeLong(initialFrame.Content, MapEventJournalReadCodecRequestStartSequenceOffset, startSequence)
+	EncodeInt(initialFrame.Content, MapEventJournalReadCodecRequestMinSizeOffset, minSize)
+	EncodeInt(initialFrame.Content, MapEventJournalReadCodecRequestMaxSizeOffset, maxSize)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(MapEventJournalReadCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeNullableForData(clientMessage, predicate)
+	EncodeNullableForData(clientMessage, projection)
+
+	return clientMessage
+}
+
+func DecodeMapEventJournalReadResponse(clientMessage *proto.ClientMessage) (readCount int32, nextSeq int64, items []*iserialization.Data, itemSeqs []int64) {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	readCount = DecodeInt(initialFrame.Content, MapEventJournalReadResponseReadCountOffset)
+	nextSeq = DecodeLong(initialFrame.Content, MapEventJournalReadResponseNextSeqOffset)
+	items = DecodeListMultiFrameForData(frameIterator)
+	itemSeqs = DecodeNullableForLongArray(frameIterator)
+
+	return readCount, nextSeq, items, itemSeqs
+}
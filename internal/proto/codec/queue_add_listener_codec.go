@@ -0,0 +1,73 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	iserialization "github.com/hazelcast/hazelcast-go-client"
+	proto "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	QueueAddListenerCodecRequestMessageType   = int32(0x031100)
+	QueueAddListenerCodecResponseMessageType  = int32(0x031101)
+	QueueAddListenerCodecEventItemMessageType = int32(0x031102)
+
+	QueueAddListenerCodecRequestIncludeValueOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	QueueAddListenerCodecRequestLocalOnlyOffset    = QueueAddListenerCodecRequestIncludeValueOffset + proto.BooleanSizeInBytes
+	QueueAddListenerCodecRequestInitialFrameSize   = QueueAddListenerCodecRequestLocalOnlyOffset + proto.BooleanSizeInBytes
+	QueueAddListenerResponseResponseOffset         = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+	QueueAddListenerEventItemUuidOffset            = proto.PartitionIDOffset + proto.IntSizeInBytes
+	QueueAddListenerEventItemEventTypeOffset       = QueueAddListenerEventItemUuidOffset + proto.UUIDSizeInBytes
+)
+
+// Adds an listener for this collection. Listener will be notified or all collection add/remove events.
+
+func EncodeQueueAddListenerRequest(name string, includeValue bool, localOnly bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, QueueAddListenerCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	EncodeBoolean(initialFrame.Content, QueueAddListenerCodecRequestIncludeValueOffset, includeValue)
+	EncodeBoolean(initialFrame.Content, QueueAddListenerCodecRequestLocalOnlyOffset, localOnly)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(QueueAddListenerCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeQueueAddListenerResponse(clientMessage *proto.ClientMessage) types.UUID {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return DecodeUUID(initialFrame.Content, QueueAddListenerResponseResponseOffset)
+}
+
+func HandleQueueAddListener(clientMessage *proto.ClientMessage, handleItemEvent func(item iserialization.Data, uuid types.UUID, eventType int32)) {
+	messageType := clientMessage.Type()
+	frameIterator := clientMessage.FrameIterator()
+	if messageType == QueueAddListenerCodecEventItemMessageType {
+		initialFrame := frameIterator.Next()
+		uuid := DecodeUUID(initialFrame.Content, QueueAddListenerEventItemUuidOffset)
+		eventType := DecodeInt(initialFrame.Content, QueueAddListenerEventItemEventTypeOffset)
+		item := DecodeNullableForData(frameIterator)
+		handleItemEvent(item, uuid, eventType)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+* Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+*
+* Licensed under the Apache License, Version 2.0 (the "License")
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package codec
+
+import (
+	iserialization "github.com/hazelcast/hazelcast-go-client"
+	proto "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	SetAddListenerCodecRequestMessageType   = int32(0x060B00)
+	SetAddListenerCodecResponseMessageType  = int32(0x060B01)
+	SetAddListenerCodecEventItemMessageType = int32(0x060B02)
+
+	SetAddListenerCodecRequestIncludeValueOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	SetAddListenerCodecRequestLocalOnlyOffset    = SetAddListenerCodecRequestIncludeValueOffset + proto.BooleanSizeInBytes
+	SetAddListenerCodecRequestInitialFrameSize   = SetAddListenerCodecRequestLocalOnlyOffset + proto.BooleanSizeInBytes
+	SetAddListenerResponseResponseOffset         = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+	SetAddListenerEventItemUuidOffset            = proto.PartitionIDOffset + proto.IntSizeInBytes
+	SetAddListenerEventItemEventTypeOffset       = SetAddListenerEventItemUuidOffset + proto.UUIDSizeInBytes
+)
+
+// Adds an item listener for this collection. Listener will be notified for all collection add/remove events.
+
+func EncodeSetAddListenerRequest(name string, includeValue bool, localOnly bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, SetAddListenerCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	EncodeBoolean(initialFrame.Content, SetAddListenerCodecRequestIncludeValueOffset, includeValue)
+	EncodeBoolean(initialFrame.Content, SetAddListenerCodecRequestLocalOnlyOffset, localOnly)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(SetAddListenerCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeSetAddListenerResponse(clientMessage *proto.ClientMessage) types.UUID {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return DecodeUUID(initialFrame.Content, SetAddListenerResponseResponseOffset)
+}
+
+func HandleSetAddListener(clientMessage *proto.ClientMessage, handleItemEvent func(item iserialization.Data, uuid types.UUID, eventType int32)) {
+	messageType := clientMessage.Type()
+	frameIterator := clientMessage.FrameIterator()
+	if messageType == SetAddListenerCodecEventItemMessageType {
+		initialFrame := frameIterator.Next()
+		uuid := DecodeUUID(initialFrame.Content, SetAddListenerEventItemUuidOffset)
+		eventType := DecodeInt(initialFrame.Content, SetAddListenerEventItemEventTypeOffset)
+		item := DecodeNullableForData(frameIterator)
+		handleItemEvent(item, uuid, eventType)
+	}
+}
@@ -0,0 +1,102 @@
+package serialization
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	nullDataArrayLength = -1
+	nullStringLength    = -1
+)
+
+type byteReader struct {
+	b   []byte
+	pos int
+}
+
+func (r *byteReader) skip(n int) error {
+	if r.pos+n > len(r.b) {
+		return errors.New("unexpected end of data")
+	}
+	r.pos += n
+	return nil
+}
+
+func (r *byteReader) readByte() (byte, error) {
+	if err := r.skip(1); err != nil {
+		return 0, err
+	}
+	return r.b[r.pos-1], nil
+}
+
+func (r *byteReader) readUint16() (uint16, error) {
+	if err := r.skip(2); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint16(r.b[r.pos-2:]), nil
+}
+
+func (r *byteReader) readInt32() (int32, error) {
+	if err := r.skip(4); err != nil {
+		return 0, err
+	}
+	return int32(binary.BigEndian.Uint32(r.b[r.pos-4:])), nil
+}
+
+// readData reads a length prefixed serialized value.
+// Returns nil if the value is null.
+func (r *byteReader) readData() ([]byte, error) {
+	n, err := r.readInt32()
+	if err != nil {
+		return nil, err
+	}
+	if n == nullDataArrayLength {
+		return nil, nil
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("invalid data length: %d", n)
+	}
+	return r.readBytes(int(n))
+}
+
+func (r *byteReader) readBytes(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("invalid length: %d", n)
+	}
+	if err := r.skip(n); err != nil {
+		return nil, err
+	}
+	return r.b[r.pos-n : r.pos], nil
+}
+
+// readString reads a string in the Hazelcast format, which is prefixed with its length as a 32-bit integer.
+// Returns an empty string if the string is null.
+func (r *byteReader) readString() (string, error) {
+	n, err := r.readInt32()
+	if err != nil {
+		return "", err
+	}
+	if n == nullStringLength {
+		return "", nil
+	}
+	b, err := r.readBytes(int(n))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// readUTF reads a string in the Java serialization format, which is prefixed with its length as a 16-bit integer.
+func (r *byteReader) readUTF() (string, error) {
+	n, err := r.readUint16()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.readBytes(int(n))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
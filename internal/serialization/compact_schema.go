@@ -0,0 +1,172 @@
+package serialization
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"gopkg.in/yaml.v3"
+)
+
+// CompactSchema is the user provided definition of a Compact type.
+type CompactSchema struct {
+	TypeName string               `yaml:"type-name"`
+	Fields   []CompactFieldSchema `yaml:"fields"`
+}
+
+// CompactFieldSchema is the definition of a field of a Compact type.
+// TypeName is required only for the COMPACT and ARRAY_OF_COMPACT fields, and it is the type name of the nested value.
+type CompactFieldSchema struct {
+	Name     string `yaml:"name"`
+	Kind     string `yaml:"kind"`
+	TypeName string `yaml:"type-name,omitempty"`
+}
+
+type compactSchemaFile struct {
+	Types []CompactSchema `yaml:"types"`
+}
+
+// LoadCompactSchemas loads the Compact type definitions in the given YAML or JSON file.
+func LoadCompactSchemas(path string) ([]CompactSchema, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f compactSchemaFile
+	if err := yaml.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("loading Compact schemas from %s: %w", path, err)
+	}
+	for _, s := range f.Types {
+		if err := s.validate(); err != nil {
+			return nil, fmt.Errorf("loading Compact schemas from %s: %w", path, err)
+		}
+	}
+	return f.Types, nil
+}
+
+// MarshalCompactSchemas returns the given schemas in the Compact schema file format.
+func MarshalCompactSchemas(schemas []CompactSchema) ([]byte, error) {
+	return yaml.Marshal(compactSchemaFile{Types: schemas})
+}
+
+func (s CompactSchema) validate() error {
+	if s.TypeName == "" {
+		return fmt.Errorf("type name is required")
+	}
+	names := make(map[string]struct{}, len(s.Fields))
+	for _, f := range s.Fields {
+		if f.Name == "" {
+			return fmt.Errorf("%s: field name is required", s.TypeName)
+		}
+		if _, ok := names[f.Name]; ok {
+			return fmt.Errorf("%s: duplicate field: %s", s.TypeName, f.Name)
+		}
+		names[f.Name] = struct{}{}
+		if _, ok := CompactFieldKind(f.Kind); !ok {
+			return fmt.Errorf("%s: unknown kind for field %s: %s", s.TypeName, f.Name, f.Kind)
+		}
+	}
+	return nil
+}
+
+// ID returns the schema ID, which is the Rabin fingerprint of the type name and the fields sorted by name.
+// It is the same with the schema ID computed by Hazelcast.
+// The schema must be valid.
+func (s CompactSchema) ID() int64 {
+	fs := make([]CompactFieldSchema, len(s.Fields))
+	copy(fs, s.Fields)
+	sort.Slice(fs, func(i, j int) bool {
+		return fs[i].Name < fs[j].Name
+	})
+	fp := rabinFingerprintOfString(rabinFingerprintInit, s.TypeName)
+	fp = rabinFingerprintOfInt32(fp, int32(len(fs)))
+	for _, f := range fs {
+		kind, _ := CompactFieldKind(f.Kind)
+		fp = rabinFingerprintOfString(fp, f.Name)
+		fp = rabinFingerprintOfInt32(fp, int32(kind))
+	}
+	return fp
+}
+
+// compactSchemaOf returns the Compact schema for the given schema received from the cluster.
+// The type names of the nested Compact fields are not known, so they are left blank.
+func compactSchemaOf(schema *hazelcast.Schema) CompactSchema {
+	fds := schema.FieldDefinitions()
+	fs := make([]CompactFieldSchema, len(fds))
+	for i, fd := range fds {
+		fs[i] = CompactFieldSchema{
+			Name: fd.Name,
+			Kind: CompactFieldKindName(fd.Kind),
+		}
+	}
+	return CompactSchema{
+		TypeName: schema.TypeName,
+		Fields:   fs,
+	}
+}
+
+// CompactFieldKind returns the field kind with the given name.
+// The names are the same with the ones in com.hazelcast.nio.serialization.FieldKind, and they are case-insensitive.
+// Portable and char kinds are not supported, since they cannot be used with Compact serialization.
+func CompactFieldKind(name string) (serialization.FieldKind, bool) {
+	k, ok := compactFieldKinds[strings.ToUpper(name)]
+	return k, ok
+}
+
+// CompactFieldKindName returns the name of the given field kind.
+func CompactFieldKindName(kind serialization.FieldKind) string {
+	for name, k := range compactFieldKinds {
+		if k == kind {
+			return name
+		}
+	}
+	return fmt.Sprintf("UNKNOWN(%d)", kind)
+}
+
+var compactFieldKinds = map[string]serialization.FieldKind{
+	"BOOLEAN":                          serialization.FieldKindBoolean,
+	"ARRAY_OF_BOOLEAN":                 serialization.FieldKindArrayOfBoolean,
+	"INT8":                             serialization.FieldKindInt8,
+	"ARRAY_OF_INT8":                    serialization.FieldKindArrayOfInt8,
+	"INT16":                            serialization.FieldKindInt16,
+	"ARRAY_OF_INT16":                   serialization.FieldKindArrayOfInt16,
+	"INT32":                            serialization.FieldKindInt32,
+	"ARRAY_OF_INT32":                   serialization.FieldKindArrayOfInt32,
+	"INT64":                            serialization.FieldKindInt64,
+	"ARRAY_OF_INT64":                   serialization.FieldKindArrayOfInt64,
+	"FLOAT32":                          serialization.FieldKindFloat32,
+	"ARRAY_OF_FLOAT32":                 serialization.FieldKindArrayOfFloat32,
+	"FLOAT64":                          serialization.FieldKindFloat64,
+	"ARRAY_OF_FLOAT64":                 serialization.FieldKindArrayOfFloat64,
+	"STRING":                           serialization.FieldKindString,
+	"ARRAY_OF_STRING":                  serialization.FieldKindArrayOfString,
+	"DECIMAL":                          serialization.FieldKindDecimal,
+	"ARRAY_OF_DECIMAL":                 serialization.FieldKindArrayOfDecimal,
+	"TIME":                             serialization.FieldKindTime,
+	"ARRAY_OF_TIME":                    serialization.FieldKindArrayOfTime,
+	"DATE":                             serialization.FieldKindDate,
+	"ARRAY_OF_DATE":                    serialization.FieldKindArrayOfDate,
+	"TIMESTAMP":                        serialization.FieldKindTimestamp,
+	"ARRAY_OF_TIMESTAMP":               serialization.FieldKindArrayOfTimestamp,
+	"TIMESTAMP_WITH_TIMEZONE":          serialization.FieldKindTimestampWithTimezone,
+	"ARRAY_OF_TIMESTAMP_WITH_TIMEZONE": serialization.FieldKindArrayOfTimestampWithTimezone,
+	"COMPACT":                          serialization.FieldKindCompact,
+	"ARRAY_OF_COMPACT":                 serialization.FieldKindArrayOfCompact,
+	"NULLABLE_BOOLEAN":                 serialization.FieldKindNullableBoolean,
+	"ARRAY_OF_NULLABLE_BOOLEAN":        serialization.FieldKindArrayOfNullableBoolean,
+	"NULLABLE_INT8":                    serialization.FieldKindNullableInt8,
+	"ARRAY_OF_NULLABLE_INT8":           serialization.FieldKindArrayOfNullableInt8,
+	"NULLABLE_INT16":                   serialization.FieldKindNullableInt16,
+	"ARRAY_OF_NULLABLE_INT16":          serialization.FieldKindArrayOfNullableInt16,
+	"NULLABLE_INT32":                   serialization.FieldKindNullableInt32,
+	"ARRAY_OF_NULLABLE_INT32":          serialization.FieldKindArrayOfNullableInt32,
+	"NULLABLE_INT64":                   serialization.FieldKindNullableInt64,
+	"ARRAY_OF_NULLABLE_INT64":          serialization.FieldKindArrayOfNullableInt64,
+	"NULLABLE_FLOAT32":                 serialization.FieldKindNullableFloat32,
+	"ARRAY_OF_NULLABLE_FLOAT32":        serialization.FieldKindArrayOfNullableFloat32,
+	"NULLABLE_FLOAT64":                 serialization.FieldKindNullableFloat64,
+	"ARRAY_OF_NULLABLE_FLOAT64":        serialization.FieldKindArrayOfNullableFloat64,
+}
@@ -0,0 +1,184 @@
+package serialization
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"gopkg.in/yaml.v3"
+)
+
+// DataLayout is the user provided description of how an IdentifiedDataSerializable value or a value serialized by a custom serializer is written.
+// IdentifiedDataSerializable values are identified by FactoryID and ClassID.
+// Values serialized by a custom serializer are identified by TypeID, which is the ID of the serializer.
+type DataLayout struct {
+	FactoryID int32             `yaml:"factory-id"`
+	ClassID   int32             `yaml:"class-id"`
+	TypeID    int32             `yaml:"type-id"`
+	Fields    []DataLayoutField `yaml:"fields"`
+}
+
+// DataLayoutField is a field of a data layout.
+// Read is the name of the com.hazelcast.nio.ObjectDataInput method which reads the field, e.g., readInt.
+type DataLayoutField struct {
+	Name string `yaml:"name"`
+	Read string `yaml:"read"`
+}
+
+type dataLayoutFile struct {
+	Layouts []DataLayout `yaml:"layouts"`
+}
+
+// LoadDataLayouts loads the data layouts in the given YAML or JSON file.
+func LoadDataLayouts(path string) ([]DataLayout, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f dataLayoutFile
+	if err := yaml.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("loading data layouts from %s: %w", path, err)
+	}
+	for _, l := range f.Layouts {
+		if err := l.validate(); err != nil {
+			return nil, fmt.Errorf("loading data layouts from %s: %w", path, err)
+		}
+	}
+	return f.Layouts, nil
+}
+
+// IsCustom returns true if the layout is for a value serialized by a custom serializer.
+func (l DataLayout) IsCustom() bool {
+	return l.TypeID != 0
+}
+
+func (l DataLayout) String() string {
+	if l.IsCustom() {
+		return fmt.Sprintf("type %d", l.TypeID)
+	}
+	return fmt.Sprintf("%d:%d", l.FactoryID, l.ClassID)
+}
+
+func (l DataLayout) validate() error {
+	if l.IsCustom() {
+		if l.TypeID < 0 {
+			return fmt.Errorf("%s: type ID must be positive", l)
+		}
+		if l.FactoryID != 0 || l.ClassID != 0 {
+			return fmt.Errorf("%s: factory ID and class ID cannot be used with type ID", l)
+		}
+	} else if l.FactoryID == snapshotFactoryID {
+		return fmt.Errorf("%s: factory ID %d is reserved", l, snapshotFactoryID)
+	}
+	names := make(map[string]struct{}, len(l.Fields))
+	for _, f := range l.Fields {
+		if f.Name == "" {
+			return fmt.Errorf("%s: field name is required", l)
+		}
+		if _, ok := names[f.Name]; ok {
+			return fmt.Errorf("%s: duplicate field: %s", l, f.Name)
+		}
+		names[f.Name] = struct{}{}
+		if _, ok := DataFieldReader(f.Read); !ok {
+			return fmt.Errorf("%s: unknown read method for field %s: %s", l, f.Name, f.Read)
+		}
+	}
+	return nil
+}
+
+type dataFieldReader func(input serialization.DataInput, name string) Column
+
+// DataFieldReader returns the reader for the given read method name.
+// Both the com.hazelcast.nio.ObjectDataInput and the serialization.DataInput method names are supported, and they are case-insensitive.
+func DataFieldReader(method string) (dataFieldReader, bool) {
+	r, ok := dataFieldReaders[strings.ToLower(method)]
+	return r, ok
+}
+
+var dataFieldReaders = map[string]dataFieldReader{}
+
+func init() {
+	readers := []struct {
+		names []string
+		read  dataFieldReader
+	}{
+		{[]string{"readByte"}, func(in serialization.DataInput, name string) Column {
+			return byteToColumn(name, in.ReadByte())
+		}},
+		{[]string{"readBoolean", "readBool"}, func(in serialization.DataInput, name string) Column {
+			return boolToColumn(name, in.ReadBool())
+		}},
+		{[]string{"readChar", "readUInt16"}, func(in serialization.DataInput, name string) Column {
+			return uint16ToColumn(name, in.ReadUInt16())
+		}},
+		{[]string{"readShort", "readInt16"}, func(in serialization.DataInput, name string) Column {
+			return int16ToColumn(name, in.ReadInt16())
+		}},
+		{[]string{"readInt", "readInt32"}, func(in serialization.DataInput, name string) Column {
+			return int32ToColumn(name, in.ReadInt32())
+		}},
+		{[]string{"readLong", "readInt64"}, func(in serialization.DataInput, name string) Column {
+			return int64ToColumn(name, in.ReadInt64())
+		}},
+		{[]string{"readFloat", "readFloat32"}, func(in serialization.DataInput, name string) Column {
+			return float32ToColumn(name, in.ReadFloat32())
+		}},
+		{[]string{"readDouble", "readFloat64"}, func(in serialization.DataInput, name string) Column {
+			return float64ToColumn(name, in.ReadFloat64())
+		}},
+		{[]string{"readString", "readUTF"}, func(in serialization.DataInput, name string) Column {
+			return stringToColumn(name, in.ReadString())
+		}},
+		{[]string{"readObject"}, readObjectColumn},
+		{[]string{"readByteArray"}, func(in serialization.DataInput, name string) Column {
+			return arrayToColumn(name, TypeByteArray, in.ReadByteArray(), byteToColumn)
+		}},
+		{[]string{"readBooleanArray", "readBoolArray"}, func(in serialization.DataInput, name string) Column {
+			return arrayToColumn(name, TypeBoolArray, in.ReadBoolArray(), boolToColumn)
+		}},
+		{[]string{"readCharArray", "readUInt16Array"}, func(in serialization.DataInput, name string) Column {
+			return arrayToColumn(name, TypeUInt16Array, in.ReadUInt16Array(), uint16ToColumn)
+		}},
+		{[]string{"readShortArray", "readInt16Array"}, func(in serialization.DataInput, name string) Column {
+			return arrayToColumn(name, TypeInt16Array, in.ReadInt16Array(), int16ToColumn)
+		}},
+		{[]string{"readIntArray", "readInt32Array"}, func(in serialization.DataInput, name string) Column {
+			return arrayToColumn(name, TypeInt32Array, in.ReadInt32Array(), int32ToColumn)
+		}},
+		{[]string{"readLongArray", "readInt64Array"}, func(in serialization.DataInput, name string) Column {
+			return arrayToColumn(name, TypeInt64Array, in.ReadInt64Array(), int64ToColumn)
+		}},
+		{[]string{"readFloatArray", "readFloat32Array"}, func(in serialization.DataInput, name string) Column {
+			return arrayToColumn(name, TypeFloat32Array, in.ReadFloat32Array(), float32ToColumn)
+		}},
+		{[]string{"readDoubleArray", "readFloat64Array"}, func(in serialization.DataInput, name string) Column {
+			return arrayToColumn(name, TypeFloat64Array, in.ReadFloat64Array(), float64ToColumn)
+		}},
+		{[]string{"readStringArray", "readUTFArray"}, func(in serialization.DataInput, name string) Column {
+			return arrayToColumn(name, TypeStringArray, in.ReadStringArray(), stringToColumn)
+		}},
+	}
+	for _, r := range readers {
+		for _, n := range r.names {
+			dataFieldReaders[strings.ToLower(n)] = r.read
+		}
+	}
+}
+
+// readObjectColumn reads a nested object.
+// The serialization type of the object precedes it, so it is peeked to set the column type.
+func readObjectColumn(in serialization.DataInput, name string) Column {
+	pos := in.Position()
+	t := in.ReadInt32()
+	in.SetPosition(pos)
+	v := in.ReadObject()
+	if v == nil {
+		t = TypeNil
+	}
+	return Column{
+		Name:  name,
+		Type:  t,
+		Value: v,
+	}
+}
@@ -0,0 +1,145 @@
+package serialization
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterDataLayouts(t *testing.T) {
+	layouts, err := LoadDataLayouts("testdata/data_layouts.yaml")
+	require.NoError(t, err)
+	fs, sers, err := RegisterDataLayouts(layouts)
+	require.NoError(t, err)
+	require.Len(t, fs, 1)
+	require.Equal(t, int32(1), fs[0].FactoryID())
+	require.Nil(t, fs[0].Create(3))
+	require.Len(t, sers, 1)
+	require.Equal(t, int32(1000), sers[0].ID())
+	v := fs[0].Create(1)
+	require.Equal(t, int32(1), v.ClassID())
+	in := &testDataInput{}
+	in.writeInt64(42)
+	in.writeString("Jane")
+	in.writeInt32(2)
+	in.writeInt32(5)
+	in.writeInt32(7)
+	v.ReadData(in)
+	gd := v.(*GenericData)
+	require.Equal(t, "{id:42; name:Jane; scores:[5, 7]}", gd.Text())
+	jv, err := gd.JSONValue()
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"id": int64(42), "name": "Jane", "scores": []any{int32(5), int32(7)}}, jv)
+	in = &testDataInput{}
+	in.writeInt32(3)
+	cv := sers[0].Read(in).(*GenericData)
+	require.Equal(t, int32(1000), cv.TypeID())
+	require.Equal(t, "{count:3}", cv.Text())
+}
+
+func TestRegisterDataLayouts_Error(t *testing.T) {
+	testCases := []struct {
+		name    string
+		layouts []DataLayout
+		errText string
+	}{
+		{
+			name:    "unknown read method",
+			layouts: []DataLayout{{FactoryID: 1, ClassID: 1, Fields: []DataLayoutField{{Name: "a", Read: "readFoo"}}}},
+			errText: "1:1: unknown read method for field a: readFoo",
+		},
+		{
+			name:    "duplicate field",
+			layouts: []DataLayout{{FactoryID: 1, ClassID: 1, Fields: []DataLayoutField{{Name: "a", Read: "readInt"}, {Name: "a", Read: "readInt"}}}},
+			errText: "1:1: duplicate field: a",
+		},
+		{
+			name:    "duplicate class",
+			layouts: []DataLayout{{FactoryID: 1, ClassID: 1}, {FactoryID: 1, ClassID: 1}},
+			errText: "duplicate data layout: 1:1",
+		},
+		{
+			name:    "duplicate type",
+			layouts: []DataLayout{{TypeID: 5}, {TypeID: 5}},
+			errText: "duplicate data layout: type 5",
+		},
+		{
+			name:    "negative type ID",
+			layouts: []DataLayout{{TypeID: -5}},
+			errText: "type -5: type ID must be positive",
+		},
+		{
+			name:    "type ID with class ID",
+			layouts: []DataLayout{{TypeID: 5, ClassID: 1}},
+			errText: "type 5: factory ID and class ID cannot be used with type ID",
+		},
+		{
+			name:    "reserved factory ID",
+			layouts: []DataLayout{{FactoryID: snapshotFactoryID, ClassID: 1}},
+			errText: "-10002:1: factory ID -10002 is reserved",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := RegisterDataLayouts(tc.layouts)
+			require.EqualError(t, err, tc.errText)
+		})
+	}
+}
+
+// testDataInput implements the parts of serialization.DataInput used in the tests.
+type testDataInput struct {
+	serialization.DataInput
+	buf []byte
+	pos int32
+}
+
+func (in *testDataInput) writeInt32(v int32) {
+	in.buf = binary.BigEndian.AppendUint32(in.buf, uint32(v))
+}
+
+func (in *testDataInput) writeInt64(v int64) {
+	in.buf = binary.BigEndian.AppendUint64(in.buf, uint64(v))
+}
+
+func (in *testDataInput) writeString(s string) {
+	in.writeInt32(int32(len(s)))
+	in.buf = append(in.buf, s...)
+}
+
+func (in *testDataInput) Position() int32 {
+	return in.pos
+}
+
+func (in *testDataInput) SetPosition(pos int32) {
+	in.pos = pos
+}
+
+func (in *testDataInput) ReadInt32() int32 {
+	v := int32(binary.BigEndian.Uint32(in.buf[in.pos:]))
+	in.pos += 4
+	return v
+}
+
+func (in *testDataInput) ReadInt64() int64 {
+	v := int64(binary.BigEndian.Uint64(in.buf[in.pos:]))
+	in.pos += 8
+	return v
+}
+
+func (in *testDataInput) ReadString() string {
+	n := in.ReadInt32()
+	s := string(in.buf[in.pos : in.pos+n])
+	in.pos += n
+	return s
+}
+
+func (in *testDataInput) ReadInt32Array() []int32 {
+	vs := make([]int32, in.ReadInt32())
+	for i := range vs {
+		vs[i] = in.ReadInt32()
+	}
+	return vs
+}
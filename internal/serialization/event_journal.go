@@ -0,0 +1,67 @@
+package serialization
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	// DataHeaderSize is the size of the partition hash and the type ID before the payload of a serialized value.
+	DataHeaderSize    = 8
+	dataTypeOffset    = 4
+	idsFlagIdentified = 1 << 0
+	idsFlagVersioned  = 1 << 1
+)
+
+// JournalEvent is a map or cache event read from the event journal.
+// The key and the values are kept serialized.
+type JournalEvent struct {
+	Key       []byte
+	NewValue  []byte
+	OldValue  []byte
+	EventType int32
+}
+
+// DecodeJournalEvent decodes the serialized form of a map or cache event journal event.
+// The event is an IdentifiedDataSerializable which has the serialized key, new value, old value and the event type.
+// The payload is decoded by its layout, so it does not depend on the class IDs of the member.
+func DecodeJournalEvent(data []byte) (JournalEvent, error) {
+	var ev JournalEvent
+	if len(data) < DataHeaderSize {
+		return ev, errors.New("invalid event data")
+	}
+	if t := int32(binary.BigEndian.Uint32(data[dataTypeOffset:])); t != TypeDataSerializable {
+		return ev, fmt.Errorf("unexpected event type: %s", TypeToLabel(t))
+	}
+	r := byteReader{b: data, pos: DataHeaderSize}
+	header, err := r.readByte()
+	if err != nil {
+		return ev, err
+	}
+	if header&idsFlagIdentified != 0 {
+		// skip factory ID and class ID
+		if err := r.skip(8); err != nil {
+			return ev, err
+		}
+	}
+	if header&idsFlagVersioned != 0 {
+		// skip major and minor version
+		if err := r.skip(2); err != nil {
+			return ev, err
+		}
+	}
+	if ev.Key, err = r.readData(); err != nil {
+		return ev, err
+	}
+	if ev.NewValue, err = r.readData(); err != nil {
+		return ev, err
+	}
+	if ev.OldValue, err = r.readData(); err != nil {
+		return ev, err
+	}
+	if ev.EventType, err = r.readInt32(); err != nil {
+		return ev, err
+	}
+	return ev, nil
+}
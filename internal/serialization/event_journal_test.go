@@ -0,0 +1,71 @@
+package serialization
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeJournalEvent(t *testing.T) {
+	key := []byte{0, 0, 0, 0, 0, 0, 0, 1, 42}
+	value := []byte{0, 0, 0, 0, 0, 0, 0, 2, 43}
+	testCases := []struct {
+		name   string
+		header byte
+		old    []byte
+		target JournalEvent
+	}{
+		{
+			name:   "identified",
+			header: idsFlagIdentified,
+			target: JournalEvent{Key: key, NewValue: value, EventType: 1},
+		},
+		{
+			name:   "identified and versioned",
+			header: idsFlagIdentified | idsFlagVersioned,
+			old:    value,
+			target: JournalEvent{Key: key, NewValue: value, OldValue: value, EventType: 1},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := append(newIDSData(), tc.header)
+			if tc.header&idsFlagIdentified != 0 {
+				b = binary.BigEndian.AppendUint32(b, uint32(0xfffffff6))
+				b = binary.BigEndian.AppendUint32(b, 103)
+			}
+			if tc.header&idsFlagVersioned != 0 {
+				b = append(b, 5, 3)
+			}
+			for _, d := range [][]byte{key, value, tc.old} {
+				if d == nil {
+					b = binary.BigEndian.AppendUint32(b, uint32(0xffffffff))
+					continue
+				}
+				b = binary.BigEndian.AppendUint32(b, uint32(len(d)))
+				b = append(b, d...)
+			}
+			b = binary.BigEndian.AppendUint32(b, 1)
+			ev, err := DecodeJournalEvent(b)
+			require.NoError(t, err)
+			require.Equal(t, tc.target, ev)
+		})
+	}
+}
+
+func TestDecodeJournalEvent_Invalid(t *testing.T) {
+	_, err := DecodeJournalEvent([]byte{0, 0, 0, 0, 0, 0, 0, 1, 1})
+	require.Error(t, err)
+	b := append(newIDSData(), 0)
+	b = binary.BigEndian.AppendUint32(b, 100)
+	_, err = DecodeJournalEvent(b)
+	require.Error(t, err)
+}
+
+func newIDSData() []byte {
+	b := make([]byte, DataHeaderSize)
+	var t int32 = TypeDataSerializable
+	binary.BigEndian.PutUint32(b[dataTypeOffset:], uint32(t))
+	return b
+}
@@ -0,0 +1,62 @@
+package serialization
+
+import (
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+const (
+	expiryPolicyFactoryID = -25
+	expiryPolicyClassID   = 19
+	// javaTimeUnitMilliseconds is the ordinal of TimeUnit.MILLISECONDS in Java.
+	javaTimeUnitMilliseconds = 2
+	durationUnset            = -1
+)
+
+// ExpiryPolicy is the counterpart of com.hazelcast.cache.HazelcastExpiryPolicy.
+// Negative durations are sent as unset, which leaves the corresponding expiry time unchanged.
+type ExpiryPolicy struct {
+	Create time.Duration
+	Access time.Duration
+	Update time.Duration
+}
+
+func (p *ExpiryPolicy) FactoryID() int32 {
+	return expiryPolicyFactoryID
+}
+
+func (p *ExpiryPolicy) ClassID() int32 {
+	return expiryPolicyClassID
+}
+
+func (p *ExpiryPolicy) WriteData(output serialization.DataOutput) {
+	writeJavaDuration(output, p.Create)
+	writeJavaDuration(output, p.Access)
+	writeJavaDuration(output, p.Update)
+}
+
+func (p *ExpiryPolicy) ReadData(input serialization.DataInput) {
+	p.Create = readJavaDuration(input)
+	p.Access = readJavaDuration(input)
+	p.Update = readJavaDuration(input)
+}
+
+func writeJavaDuration(output serialization.DataOutput, d time.Duration) {
+	if d < 0 {
+		output.WriteInt64(durationUnset)
+		return
+	}
+	output.WriteInt64(d.Milliseconds())
+	output.WriteInt32(javaTimeUnitMilliseconds)
+}
+
+func readJavaDuration(input serialization.DataInput) time.Duration {
+	amount := input.ReadInt64()
+	if amount <= durationUnset {
+		return durationUnset
+	}
+	// the time unit is always milliseconds for the durations written by CLC
+	input.ReadInt32()
+	return time.Duration(amount) * time.Millisecond
+}
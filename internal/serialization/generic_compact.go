@@ -3,6 +3,7 @@ package serialization
 import (
 	"reflect"
 	"sort"
+	"sync"
 
 	"github.com/hazelcast/hazelcast-go-client"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
@@ -23,9 +24,20 @@ type SchemaInfo struct {
 	TypeName string
 }
 
-type GenericCompactDeserializer struct{}
+type GenericCompactDeserializer struct {
+	// SchemaDir returns the directory of the local schema registry to cache the schemas of the decoded values.
+	// The schemas are not cached if it is nil.
+	SchemaDir func() string
+}
+
+// decodedSchemas keeps the schemas of the decoded Compact values by their IDs.
+var decodedSchemas sync.Map
 
 func (cm GenericCompactDeserializer) Read(schema *hazelcast.Schema, reader serialization.CompactReader) interface{} {
+	decodedSchemas.Store(schema.ID(), schema)
+	if cm.SchemaDir != nil {
+		cacheSchema(cm.SchemaDir(), schema)
+	}
 	fds := schema.FieldDefinitions()
 	cs := make(ColumnMap, len(fds))
 	for i, fd := range fds {
@@ -36,3 +48,12 @@ func (cm GenericCompactDeserializer) Read(schema *hazelcast.Schema, reader seria
 	})
 	return cs
 }
+
+// DecodedCompactSchema returns the schema with the given ID, if a value with that schema was decoded before.
+func DecodedCompactSchema(id int64) (CompactSchema, bool) {
+	v, ok := decodedSchemas.Load(id)
+	if !ok {
+		return CompactSchema{}, false
+	}
+	return compactSchemaOf(v.(*hazelcast.Schema)), true
+}
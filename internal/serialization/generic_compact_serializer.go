@@ -0,0 +1,249 @@
+package serialization
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+type compactSerializers map[string]*GenericCompactSerializer
+
+var compactTypes = struct {
+	mu          sync.RWMutex
+	serializers compactSerializers
+}{}
+
+// RegisterCompactSchemas creates the generic Compact serializers for the given schemas.
+// The returned serializers must be set in the client configuration, so the schemas are sent to the cluster.
+// The previously registered schemas are replaced.
+func RegisterCompactSchemas(schemas []CompactSchema) ([]serialization.CompactSerializer, error) {
+	sers := make(compactSerializers, len(schemas))
+	for _, s := range schemas {
+		if _, ok := sers[s.TypeName]; ok {
+			return nil, fmt.Errorf("duplicate Compact type: %s", s.TypeName)
+		}
+		ser, err := newGenericCompactSerializer(s)
+		if err != nil {
+			return nil, err
+		}
+		sers[s.TypeName] = ser
+	}
+	r := make([]serialization.CompactSerializer, 0, len(sers))
+	for _, ser := range sers {
+		for _, f := range ser.schema.Fields {
+			if f.TypeName == "" {
+				continue
+			}
+			if _, ok := sers[f.TypeName]; !ok {
+				return nil, fmt.Errorf("%s: unknown Compact type for field %s: %s", ser.schema.TypeName, f.Name, f.TypeName)
+			}
+		}
+		r = append(r, ser)
+	}
+	compactTypes.mu.Lock()
+	compactTypes.serializers = sers
+	compactTypes.mu.Unlock()
+	return r, nil
+}
+
+// NewCompactValue creates a value of the given registered Compact type from the JSON object.
+// Missing and null fields are set to their zero values.
+func NewCompactValue(typeName, text string) (any, error) {
+	compactTypes.mu.RLock()
+	sers := compactTypes.serializers
+	compactTypes.mu.RUnlock()
+	ser, ok := sers[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown Compact type: %s (the schema should be added to the configuration)", typeName)
+	}
+	d := json.NewDecoder(bytes.NewBufferString(text))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, fmt.Errorf("malformed JSON string: %w", err)
+	}
+	return ser.makeValue(sers, v)
+}
+
+// GenericCompactSerializer serializes the values of a user provided Compact schema.
+type GenericCompactSerializer struct {
+	schema CompactSchema
+	kinds  []serialization.FieldKind
+	typ    reflect.Type
+}
+
+func newGenericCompactSerializer(s CompactSchema) (*GenericCompactSerializer, error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	kinds := make([]serialization.FieldKind, len(s.Fields))
+	for i, f := range s.Fields {
+		kinds[i], _ = CompactFieldKind(f.Kind)
+		if (kinds[i] == serialization.FieldKindCompact || kinds[i] == serialization.FieldKindArrayOfCompact) && f.TypeName == "" {
+			return nil, fmt.Errorf("%s: type name is required for field %s", s.TypeName, f.Name)
+		}
+	}
+	// The Go client looks up the Compact serializer using the type of the value,
+	// so each Compact type requires a distinct Go type.
+	// Struct types with different tags are distinct.
+	typ := reflect.StructOf([]reflect.StructField{{
+		Name: "Fields",
+		Type: reflect.TypeOf(map[string]any{}),
+		Tag:  reflect.StructTag(fmt.Sprintf("compact:%q", s.TypeName)),
+	}})
+	return &GenericCompactSerializer{
+		schema: s,
+		kinds:  kinds,
+		typ:    typ,
+	}, nil
+}
+
+func (cs *GenericCompactSerializer) Type() reflect.Type {
+	return cs.typ
+}
+
+func (cs *GenericCompactSerializer) TypeName() string {
+	return cs.schema.TypeName
+}
+
+func (cs *GenericCompactSerializer) Read(reader serialization.CompactReader) interface{} {
+	cols := make(ColumnMap, 0, len(cs.schema.Fields))
+	for _, f := range cs.schema.Fields {
+		// the data may be written using another version of the schema
+		kind := reader.GetFieldKind(f.Name)
+		read, ok := compactReaders[kind]
+		if !ok {
+			continue
+		}
+		cols = append(cols, read(reader, f.Name))
+	}
+	sort.Slice(cols, func(i, j int) bool {
+		return cols[i].Name < cols[j].Name
+	})
+	return cols
+}
+
+func (cs *GenericCompactSerializer) Write(writer serialization.CompactWriter, value interface{}) {
+	fields := reflect.ValueOf(value).Field(0).Interface().(map[string]any)
+	for i, f := range cs.schema.Fields {
+		compactFieldHandlers[cs.kinds[i]].write(writer, f.Name, fields[f.Name])
+	}
+}
+
+func (cs *GenericCompactSerializer) makeValue(sers compactSerializers, v any) (any, error) {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a JSON object", cs.schema.TypeName)
+	}
+	fields := make(map[string]any, len(cs.schema.Fields))
+	for i, f := range cs.schema.Fields {
+		fv, ok := obj[f.Name]
+		if !ok || fv == nil {
+			continue
+		}
+		cv, err := cs.convertField(sers, cs.kinds[i], f, fv)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", cs.schema.TypeName, f.Name, err)
+		}
+		fields[f.Name] = cv
+	}
+	for name := range obj {
+		if _, ok := fields[name]; !ok && !cs.hasField(name) {
+			return nil, fmt.Errorf("%s: unknown field: %s", cs.schema.TypeName, name)
+		}
+	}
+	rv := reflect.New(cs.typ).Elem()
+	rv.Field(0).Set(reflect.ValueOf(fields))
+	return rv.Interface(), nil
+}
+
+func (cs *GenericCompactSerializer) convertField(sers compactSerializers, kind serialization.FieldKind, f CompactFieldSchema, v any) (any, error) {
+	switch kind {
+	case serialization.FieldKindCompact:
+		return sers[f.TypeName].makeValue(sers, v)
+	case serialization.FieldKindArrayOfCompact:
+		return jsonObjects(v, true, func(item any) (any, error) {
+			return sers[f.TypeName].makeValue(sers, item)
+		})
+	}
+	return compactFieldHandlers[kind].convert(v)
+}
+
+func (cs *GenericCompactSerializer) hasField(name string) bool {
+	for _, f := range cs.schema.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+type compactFieldWriter func(w serialization.CompactWriter, name string, v any)
+
+type compactFieldHandler struct {
+	convert jsonConverter
+	write   compactFieldWriter
+}
+
+var compactFieldHandlers = map[serialization.FieldKind]compactFieldHandler{
+	serialization.FieldKindBoolean:                      {scalar(jsonBool), writeAs(serialization.CompactWriter.WriteBoolean)},
+	serialization.FieldKindArrayOfBoolean:               {array(jsonBool), writeAs(serialization.CompactWriter.WriteArrayOfBoolean)},
+	serialization.FieldKindInt8:                         {scalar(jsonInt8), writeAs(serialization.CompactWriter.WriteInt8)},
+	serialization.FieldKindArrayOfInt8:                  {array(jsonInt8), writeAs(serialization.CompactWriter.WriteArrayOfInt8)},
+	serialization.FieldKindInt16:                        {scalar(jsonInt16), writeAs(serialization.CompactWriter.WriteInt16)},
+	serialization.FieldKindArrayOfInt16:                 {array(jsonInt16), writeAs(serialization.CompactWriter.WriteArrayOfInt16)},
+	serialization.FieldKindInt32:                        {scalar(jsonInt32), writeAs(serialization.CompactWriter.WriteInt32)},
+	serialization.FieldKindArrayOfInt32:                 {array(jsonInt32), writeAs(serialization.CompactWriter.WriteArrayOfInt32)},
+	serialization.FieldKindInt64:                        {scalar(jsonInt64), writeAs(serialization.CompactWriter.WriteInt64)},
+	serialization.FieldKindArrayOfInt64:                 {array(jsonInt64), writeAs(serialization.CompactWriter.WriteArrayOfInt64)},
+	serialization.FieldKindFloat32:                      {scalar(jsonFloat32), writeAs(serialization.CompactWriter.WriteFloat32)},
+	serialization.FieldKindArrayOfFloat32:               {array(jsonFloat32), writeAs(serialization.CompactWriter.WriteArrayOfFloat32)},
+	serialization.FieldKindFloat64:                      {scalar(jsonFloat64), writeAs(serialization.CompactWriter.WriteFloat64)},
+	serialization.FieldKindArrayOfFloat64:               {array(jsonFloat64), writeAs(serialization.CompactWriter.WriteArrayOfFloat64)},
+	serialization.FieldKindString:                       {nullable(jsonString), writeAs(serialization.CompactWriter.WriteString)},
+	serialization.FieldKindArrayOfString:                {nullableArray(jsonString), writeAs(serialization.CompactWriter.WriteArrayOfString)},
+	serialization.FieldKindDecimal:                      {nullable(jsonDecimal), writeAs(serialization.CompactWriter.WriteDecimal)},
+	serialization.FieldKindArrayOfDecimal:               {nullableArray(jsonDecimal), writeAs(serialization.CompactWriter.WriteArrayOfDecimal)},
+	serialization.FieldKindTime:                         {nullable(jsonTime), writeAs(serialization.CompactWriter.WriteTime)},
+	serialization.FieldKindArrayOfTime:                  {nullableArray(jsonTime), writeAs(serialization.CompactWriter.WriteArrayOfTime)},
+	serialization.FieldKindDate:                         {nullable(jsonDate), writeAs(serialization.CompactWriter.WriteDate)},
+	serialization.FieldKindArrayOfDate:                  {nullableArray(jsonDate), writeAs(serialization.CompactWriter.WriteArrayOfDate)},
+	serialization.FieldKindTimestamp:                    {nullable(jsonTimestamp), writeAs(serialization.CompactWriter.WriteTimestamp)},
+	serialization.FieldKindArrayOfTimestamp:             {nullableArray(jsonTimestamp), writeAs(serialization.CompactWriter.WriteArrayOfTimestamp)},
+	serialization.FieldKindTimestampWithTimezone:        {nullable(jsonTimestampWithTimezone), writeAs(serialization.CompactWriter.WriteTimestampWithTimezone)},
+	serialization.FieldKindArrayOfTimestampWithTimezone: {nullableArray(jsonTimestampWithTimezone), writeAs(serialization.CompactWriter.WriteArrayOfTimestampWithTimezone)},
+	// nested values are converted by GenericCompactSerializer.convertField
+	serialization.FieldKindCompact:                {nil, writeCompact},
+	serialization.FieldKindArrayOfCompact:         {nil, writeAs(serialization.CompactWriter.WriteArrayOfCompact)},
+	serialization.FieldKindNullableBoolean:        {nullable(jsonBool), writeAs(serialization.CompactWriter.WriteNullableBoolean)},
+	serialization.FieldKindArrayOfNullableBoolean: {nullableArray(jsonBool), writeAs(serialization.CompactWriter.WriteArrayOfNullableBoolean)},
+	serialization.FieldKindNullableInt8:           {nullable(jsonInt8), writeAs(serialization.CompactWriter.WriteNullableInt8)},
+	serialization.FieldKindArrayOfNullableInt8:    {nullableArray(jsonInt8), writeAs(serialization.CompactWriter.WriteArrayOfNullableInt8)},
+	serialization.FieldKindNullableInt16:          {nullable(jsonInt16), writeAs(serialization.CompactWriter.WriteNullableInt16)},
+	serialization.FieldKindArrayOfNullableInt16:   {nullableArray(jsonInt16), writeAs(serialization.CompactWriter.WriteArrayOfNullableInt16)},
+	serialization.FieldKindNullableInt32:          {nullable(jsonInt32), writeAs(serialization.CompactWriter.WriteNullableInt32)},
+	serialization.FieldKindArrayOfNullableInt32:   {nullableArray(jsonInt32), writeAs(serialization.CompactWriter.WriteArrayOfNullableInt32)},
+	serialization.FieldKindNullableInt64:          {nullable(jsonInt64), writeAs(serialization.CompactWriter.WriteNullableInt64)},
+	serialization.FieldKindArrayOfNullableInt64:   {nullableArray(jsonInt64), writeAs(serialization.CompactWriter.WriteArrayOfNullableInt64)},
+	serialization.FieldKindNullableFloat32:        {nullable(jsonFloat32), writeAs(serialization.CompactWriter.WriteNullableFloat32)},
+	serialization.FieldKindArrayOfNullableFloat32: {nullableArray(jsonFloat32), writeAs(serialization.CompactWriter.WriteArrayOfNullableFloat32)},
+	serialization.FieldKindNullableFloat64:        {nullable(jsonFloat64), writeAs(serialization.CompactWriter.WriteNullableFloat64)},
+	serialization.FieldKindArrayOfNullableFloat64: {nullableArray(jsonFloat64), writeAs(serialization.CompactWriter.WriteArrayOfNullableFloat64)},
+}
+
+// writeAs returns a field writer which writes the zero value if the value is not set.
+func writeAs[W, T any](f func(w W, name string, v T)) func(w W, name string, v any) {
+	return func(w W, name string, v any) {
+		t, _ := v.(T)
+		f(w, name, t)
+	}
+}
+
+func writeCompact(w serialization.CompactWriter, name string, v any) {
+	w.WriteCompact(name, v)
+}
@@ -0,0 +1,98 @@
+package serialization
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCompactValue(t *testing.T) {
+	schemas, err := LoadCompactSchemas("testdata/compact_schemas.yaml")
+	require.NoError(t, err)
+	sers, err := RegisterCompactSchemas(schemas)
+	require.NoError(t, err)
+	require.Len(t, sers, 2)
+	v, err := NewCompactValue("com.acme.User", `{
+		"id": 42,
+		"name": "Jane",
+		"tags": ["a", null],
+		"balance": "12.50",
+		"birthday": "2000-01-02",
+		"address": {"city": "Istanbul"}
+	}`)
+	require.NoError(t, err)
+	fields := compactFields(v)
+	require.Equal(t, int64(42), fields["id"])
+	require.Equal(t, "Jane", *fields["name"].(*string))
+	require.NotContains(t, fields, "score")
+	tags := fields["tags"].([]*string)
+	require.Len(t, tags, 2)
+	require.Equal(t, "a", *tags[0])
+	require.Nil(t, tags[1])
+	require.Equal(t, types.NewDecimal(big.NewInt(1250), 2), *fields["balance"].(*types.Decimal))
+	require.Equal(t, types.LocalDate(time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)), *fields["birthday"].(*types.LocalDate))
+	require.Equal(t, "Istanbul", *compactFields(fields["address"])["city"].(*string))
+}
+
+func TestNewCompactValue_Error(t *testing.T) {
+	schemas, err := LoadCompactSchemas("testdata/compact_schemas.yaml")
+	require.NoError(t, err)
+	_, err = RegisterCompactSchemas(schemas)
+	require.NoError(t, err)
+	testCases := []struct {
+		name     string
+		typeName string
+		text     string
+		errText  string
+	}{
+		{name: "unknown type", typeName: "com.acme.Foo", text: `{}`, errText: "unknown Compact type: com.acme.Foo"},
+		{name: "malformed JSON", typeName: "com.acme.User", text: `{`, errText: "malformed JSON string"},
+		{name: "not an object", typeName: "com.acme.User", text: `[]`, errText: "expected a JSON object"},
+		{name: "unknown field", typeName: "com.acme.User", text: `{"foo": 1}`, errText: "unknown field: foo"},
+		{name: "out of range", typeName: "com.acme.User", text: `{"id": 1e100}`, errText: "com.acme.User.id: expected a 64 bit integer"},
+		{name: "nested", typeName: "com.acme.User", text: `{"address": {"city": 1}}`, errText: "com.acme.Address.city: expected a string"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewCompactValue(tc.typeName, tc.text)
+			require.ErrorContains(t, err, tc.errText)
+		})
+	}
+}
+
+func TestRegisterCompactSchemas_UnknownNestedType(t *testing.T) {
+	_, err := RegisterCompactSchemas([]CompactSchema{{
+		TypeName: "foo",
+		Fields:   []CompactFieldSchema{{Name: "bar", Kind: "COMPACT", TypeName: "baz"}},
+	}})
+	require.ErrorContains(t, err, "unknown Compact type for field bar: baz")
+}
+
+func TestParseDecimal(t *testing.T) {
+	testCases := []struct {
+		s      string
+		target types.Decimal
+	}{
+		{s: "0", target: types.NewDecimal(big.NewInt(0), 0)},
+		{s: "12.345", target: types.NewDecimal(big.NewInt(12345), 3)},
+		{s: "-1.2e-3", target: types.NewDecimal(big.NewInt(-12), 4)},
+		{s: "+5E2", target: types.NewDecimal(big.NewInt(500), 0)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.s, func(t *testing.T) {
+			d, err := ParseDecimal(tc.s)
+			require.NoError(t, err)
+			require.Equal(t, tc.target, d)
+		})
+	}
+	_, err := ParseDecimal("1.2.3")
+	require.Error(t, err)
+}
+
+func compactFields(v any) map[string]any {
+	return reflect.ValueOf(v).Field(0).Interface().(map[string]any)
+}
@@ -0,0 +1,153 @@
+package serialization
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+type dataClassKey struct {
+	factoryID int32
+	classID   int32
+}
+
+// RegisterDataLayouts creates the IdentifiedDataSerializable factories and the custom serializers for the given data layouts.
+// The returned factories and serializers must be set in the client configuration.
+func RegisterDataLayouts(layouts []DataLayout) ([]serialization.IdentifiedDataSerializableFactory, []*GenericDataSerializer, error) {
+	factories := map[int32]*GenericDataFactory{}
+	var fids []int32
+	var sers []*GenericDataSerializer
+	typeIDs := map[int32]struct{}{}
+	classes := map[dataClassKey]struct{}{}
+	for _, l := range layouts {
+		if err := l.validate(); err != nil {
+			return nil, nil, err
+		}
+		if l.IsCustom() {
+			if _, ok := typeIDs[l.TypeID]; ok {
+				return nil, nil, fmt.Errorf("duplicate data layout: %s", l)
+			}
+			typeIDs[l.TypeID] = struct{}{}
+			sers = append(sers, newGenericDataSerializer(l))
+			continue
+		}
+		key := dataClassKey{factoryID: l.FactoryID, classID: l.ClassID}
+		if _, ok := classes[key]; ok {
+			return nil, nil, fmt.Errorf("duplicate data layout: %s", l)
+		}
+		classes[key] = struct{}{}
+		f, ok := factories[l.FactoryID]
+		if !ok {
+			f = &GenericDataFactory{
+				factoryID: l.FactoryID,
+				layouts:   map[int32]DataLayout{},
+			}
+			factories[l.FactoryID] = f
+			fids = append(fids, l.FactoryID)
+		}
+		f.layouts[l.ClassID] = l
+	}
+	fs := make([]serialization.IdentifiedDataSerializableFactory, len(fids))
+	for i, fid := range fids {
+		fs[i] = factories[fid]
+	}
+	return fs, sers, nil
+}
+
+// GenericDataFactory creates IdentifiedDataSerializable values which are read using data layouts.
+type GenericDataFactory struct {
+	factoryID int32
+	layouts   map[int32]DataLayout
+}
+
+func (f *GenericDataFactory) Create(classID int32) serialization.IdentifiedDataSerializable {
+	l, ok := f.layouts[classID]
+	if !ok {
+		// the Go client returns an error for nil values
+		return nil
+	}
+	return &GenericData{layout: l}
+}
+
+func (f *GenericDataFactory) FactoryID() int32 {
+	return f.factoryID
+}
+
+// GenericDataSerializer is a custom serializer which reads values using a data layout.
+type GenericDataSerializer struct {
+	layout DataLayout
+	typ    reflect.Type
+}
+
+func newGenericDataSerializer(l DataLayout) *GenericDataSerializer {
+	// The Go client registers custom serializers using the type of the value,
+	// so each custom serializer requires a distinct Go type.
+	// Struct types with different tags are distinct.
+	typ := reflect.StructOf([]reflect.StructField{{
+		Name: "Fields",
+		Type: reflect.TypeOf(ColumnMap{}),
+		Tag:  reflect.StructTag(fmt.Sprintf("data:\"%d\"", l.TypeID)),
+	}})
+	return &GenericDataSerializer{
+		layout: l,
+		typ:    typ,
+	}
+}
+
+func (s *GenericDataSerializer) Type() reflect.Type {
+	return s.typ
+}
+
+func (s *GenericDataSerializer) ID() int32 {
+	return s.layout.TypeID
+}
+
+func (s *GenericDataSerializer) Read(input serialization.DataInput) interface{} {
+	v := &GenericData{layout: s.layout}
+	v.ReadData(input)
+	return v
+}
+
+func (s *GenericDataSerializer) Write(output serialization.DataOutput, object interface{}) {
+	panic("serialization.GenericDataSerializer.Write is not supposed to be called")
+}
+
+// GenericData is a value read using a data layout.
+// The fields are in the order they are declared in the layout.
+type GenericData struct {
+	Fields ColumnMap
+	layout DataLayout
+}
+
+func (d *GenericData) FactoryID() int32 {
+	return d.layout.FactoryID
+}
+
+func (d *GenericData) ClassID() int32 {
+	return d.layout.ClassID
+}
+
+func (d *GenericData) TypeID() int32 {
+	return d.layout.TypeID
+}
+
+func (d *GenericData) WriteData(output serialization.DataOutput) {
+	panic("serialization.GenericData.WriteData is not supposed to be called")
+}
+
+func (d *GenericData) ReadData(input serialization.DataInput) {
+	d.Fields = make(ColumnMap, len(d.layout.Fields))
+	for i, f := range d.layout.Fields {
+		r, _ := DataFieldReader(f.Read)
+		d.Fields[i] = r(input, f.Name)
+	}
+}
+
+func (d *GenericData) Text() string {
+	return d.Fields.Text()
+}
+
+func (d *GenericData) JSONValue() (any, error) {
+	return d.Fields.JSONValue()
+}
@@ -0,0 +1,236 @@
+package serialization
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+type portableClassKey struct {
+	factoryID int32
+	classID   int32
+}
+
+type portableClasses map[portableClassKey]*portableClass
+
+var portableTypes = struct {
+	mu      sync.RWMutex
+	classes portableClasses
+}{}
+
+// RegisterPortableClasses creates the class definitions for the given Portable classes.
+// The returned class definitions must be set in the client configuration.
+// The previously registered classes are replaced.
+func RegisterPortableClasses(classes []PortableClass) ([]*serialization.ClassDefinition, error) {
+	pcs := make(portableClasses, len(classes))
+	for _, c := range classes {
+		if err := c.validate(); err != nil {
+			return nil, err
+		}
+		key := portableClassKey{factoryID: c.FactoryID, classID: c.ClassID}
+		if _, ok := pcs[key]; ok {
+			return nil, fmt.Errorf("duplicate Portable class: %s", c)
+		}
+		pcs[key] = newPortableClass(c)
+	}
+	cds := make([]*serialization.ClassDefinition, 0, len(pcs))
+	for _, pc := range pcs {
+		cd := serialization.NewClassDefinition(pc.FactoryID, pc.ClassID, pc.Version)
+		for i, f := range pc.Fields {
+			fd := serialization.FieldDefinition{
+				Name:    f.Name,
+				Index:   int32(i),
+				Type:    pc.types[i],
+				Version: pc.Version,
+			}
+			if fd.Type == serialization.TypePortable || fd.Type == serialization.TypePortableArray {
+				nested, ok := pcs[portableClassKey{factoryID: f.FactoryID, classID: f.ClassID}]
+				if !ok {
+					return nil, fmt.Errorf("%s: unknown Portable class for field %s: %d:%d", pc, f.Name, f.FactoryID, f.ClassID)
+				}
+				fd.FactoryID = f.FactoryID
+				fd.ClassID = f.ClassID
+				fd.Version = nested.Version
+			}
+			if err := cd.AddField(fd); err != nil {
+				return nil, err
+			}
+		}
+		cds = append(cds, cd)
+	}
+	portableTypes.mu.Lock()
+	portableTypes.classes = pcs
+	portableTypes.mu.Unlock()
+	return cds, nil
+}
+
+// NewPortableValue creates a value of the given registered Portable class from the JSON object.
+// Missing and null fields are set to their zero values.
+func NewPortableValue(factoryID, classID int32, text string) (any, error) {
+	portableTypes.mu.RLock()
+	pcs := portableTypes.classes
+	portableTypes.mu.RUnlock()
+	pc, ok := pcs[portableClassKey{factoryID: factoryID, classID: classID}]
+	if !ok {
+		return nil, fmt.Errorf("unknown Portable class: %d:%d (the class definition should be added to the configuration)", factoryID, classID)
+	}
+	d := json.NewDecoder(bytes.NewBufferString(text))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, fmt.Errorf("malformed JSON string: %w", err)
+	}
+	return pc.makeValue(pcs, v)
+}
+
+type portableClass struct {
+	PortableClass
+	types []serialization.FieldDefinitionType
+}
+
+func newPortableClass(c PortableClass) *portableClass {
+	ts := make([]serialization.FieldDefinitionType, len(c.Fields))
+	for i, f := range c.Fields {
+		ts[i], _ = PortableFieldType(f.Type)
+	}
+	return &portableClass{
+		PortableClass: c,
+		types:         ts,
+	}
+}
+
+func (pc *portableClass) makeValue(pcs portableClasses, v any) (*portableValue, error) {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a JSON object", pc)
+	}
+	fields := make(map[string]any, len(pc.Fields))
+	for i, f := range pc.Fields {
+		fv, ok := obj[f.Name]
+		if !ok || fv == nil {
+			continue
+		}
+		cv, err := pc.convertField(pcs, pc.types[i], f, fv)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", pc, f.Name, err)
+		}
+		fields[f.Name] = cv
+	}
+	for name := range obj {
+		if !pc.hasField(name) {
+			return nil, fmt.Errorf("%s: unknown field: %s", pc, name)
+		}
+	}
+	return &portableValue{class: pc, fields: fields}, nil
+}
+
+func (pc *portableClass) convertField(pcs portableClasses, t serialization.FieldDefinitionType, f PortableFieldSchema, v any) (any, error) {
+	nested := pcs[portableClassKey{factoryID: f.FactoryID, classID: f.ClassID}]
+	switch t {
+	case serialization.TypePortable:
+		return nested.makeValue(pcs, v)
+	case serialization.TypePortableArray:
+		// the items of Portable arrays cannot be null
+		vs, err := jsonObjects(v, false, func(item any) (any, error) {
+			return nested.makeValue(pcs, item)
+		})
+		if err != nil {
+			return nil, err
+		}
+		ps := make([]serialization.Portable, len(vs))
+		for i, item := range vs {
+			ps[i] = item.(*portableValue)
+		}
+		return ps, nil
+	}
+	return portableFieldHandlers[t].convert(v)
+}
+
+func (pc *portableClass) hasField(name string) bool {
+	for _, f := range pc.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// portableValue is a Portable value created from a user provided class definition.
+type portableValue struct {
+	class  *portableClass
+	fields map[string]any
+}
+
+func (p *portableValue) FactoryID() int32 {
+	return p.class.FactoryID
+}
+
+func (p *portableValue) ClassID() int32 {
+	return p.class.ClassID
+}
+
+func (p *portableValue) Version() int32 {
+	return p.class.Version
+}
+
+func (p *portableValue) WritePortable(w serialization.PortableWriter) {
+	for i, f := range p.class.Fields {
+		v := p.fields[f.Name]
+		if p.class.types[i] == serialization.TypePortable {
+			if v == nil {
+				w.WriteNilPortable(f.Name, f.FactoryID, f.ClassID)
+				continue
+			}
+			w.WritePortable(f.Name, v.(*portableValue))
+			continue
+		}
+		portableFieldHandlers[p.class.types[i]].write(w, f.Name, v)
+	}
+}
+
+func (p *portableValue) ReadPortable(r serialization.PortableReader) {
+	panic("serialization.portableValue.ReadPortable is not supposed to be called")
+}
+
+type portableFieldWriter func(w serialization.PortableWriter, name string, v any)
+
+type portableFieldHandler struct {
+	convert jsonConverter
+	write   portableFieldWriter
+}
+
+var portableFieldHandlers = map[serialization.FieldDefinitionType]portableFieldHandler{
+	serialization.TypeByte:                       {scalar(jsonByte), writeAs(serialization.PortableWriter.WriteByte)},
+	serialization.TypeBool:                       {scalar(jsonBool), writeAs(serialization.PortableWriter.WriteBool)},
+	serialization.TypeUint16:                     {scalar(jsonChar), writeAs(serialization.PortableWriter.WriteUInt16)},
+	serialization.TypeInt16:                      {scalar(jsonInt16), writeAs(serialization.PortableWriter.WriteInt16)},
+	serialization.TypeInt32:                      {scalar(jsonInt32), writeAs(serialization.PortableWriter.WriteInt32)},
+	serialization.TypeInt64:                      {scalar(jsonInt64), writeAs(serialization.PortableWriter.WriteInt64)},
+	serialization.TypeFloat32:                    {scalar(jsonFloat32), writeAs(serialization.PortableWriter.WriteFloat32)},
+	serialization.TypeFloat64:                    {scalar(jsonFloat64), writeAs(serialization.PortableWriter.WriteFloat64)},
+	serialization.TypeString:                     {scalar(jsonString), writeAs(serialization.PortableWriter.WriteString)},
+	serialization.TypeByteArray:                  {array(jsonByte), writeAs(serialization.PortableWriter.WriteByteArray)},
+	serialization.TypeBoolArray:                  {array(jsonBool), writeAs(serialization.PortableWriter.WriteBoolArray)},
+	serialization.TypeUInt16Array:                {array(jsonChar), writeAs(serialization.PortableWriter.WriteUInt16Array)},
+	serialization.TypeInt16Array:                 {array(jsonInt16), writeAs(serialization.PortableWriter.WriteInt16Array)},
+	serialization.TypeInt32Array:                 {array(jsonInt32), writeAs(serialization.PortableWriter.WriteInt32Array)},
+	serialization.TypeInt64Array:                 {array(jsonInt64), writeAs(serialization.PortableWriter.WriteInt64Array)},
+	serialization.TypeFloat32Array:               {array(jsonFloat32), writeAs(serialization.PortableWriter.WriteFloat32Array)},
+	serialization.TypeFloat64Array:               {array(jsonFloat64), writeAs(serialization.PortableWriter.WriteFloat64Array)},
+	serialization.TypeStringArray:                {array(jsonString), writeAs(serialization.PortableWriter.WriteStringArray)},
+	serialization.TypeDecimal:                    {nullable(jsonDecimal), writeAs(serialization.PortableWriter.WriteDecimal)},
+	serialization.TypeDecimalArray:               {array(jsonDecimal), writeAs(serialization.PortableWriter.WriteDecimalArray)},
+	serialization.TypeTime:                       {nullable(jsonTime), writeAs(serialization.PortableWriter.WriteTime)},
+	serialization.TypeTimeArray:                  {array(jsonTime), writeAs(serialization.PortableWriter.WriteTimeArray)},
+	serialization.TypeDate:                       {nullable(jsonDate), writeAs(serialization.PortableWriter.WriteDate)},
+	serialization.TypeDateArray:                  {array(jsonDate), writeAs(serialization.PortableWriter.WriteDateArray)},
+	serialization.TypeTimestamp:                  {nullable(jsonTimestamp), writeAs(serialization.PortableWriter.WriteTimestamp)},
+	serialization.TypeTimestampArray:             {array(jsonTimestamp), writeAs(serialization.PortableWriter.WriteTimestampArray)},
+	serialization.TypeTimestampWithTimezone:      {nullable(jsonTimestampWithTimezone), writeAs(serialization.PortableWriter.WriteTimestampWithTimezone)},
+	serialization.TypeTimestampWithTimezoneArray: {array(jsonTimestampWithTimezone), writeAs(serialization.PortableWriter.WriteTimestampWithTimezoneArray)},
+	// nested values are converted by portableClass.convertField
+	serialization.TypePortableArray: {nil, writeAs(serialization.PortableWriter.WritePortableArray)},
+}
@@ -0,0 +1,98 @@
+package serialization
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterPortableClasses(t *testing.T) {
+	classes, err := LoadPortableClasses("testdata/portable_classes.yaml")
+	require.NoError(t, err)
+	cds, err := RegisterPortableClasses(classes)
+	require.NoError(t, err)
+	require.Len(t, cds, 2)
+	var cd *serialization.ClassDefinition
+	for _, c := range cds {
+		if c.ClassID == 1 {
+			cd = c
+		}
+	}
+	require.NotNil(t, cd)
+	require.Equal(t, int32(3), cd.Version)
+	require.Equal(t, serialization.FieldDefinition{
+		Name:      "address",
+		Index:     4,
+		Type:      serialization.TypePortable,
+		FactoryID: 1,
+		ClassID:   2,
+		Version:   0,
+	}, cd.Fields["address"])
+	require.Equal(t, serialization.TypeUint16, cd.Fields["initial"].Type)
+}
+
+func TestNewPortableValue(t *testing.T) {
+	classes, err := LoadPortableClasses("testdata/portable_classes.yaml")
+	require.NoError(t, err)
+	_, err = RegisterPortableClasses(classes)
+	require.NoError(t, err)
+	v, err := NewPortableValue(1, 1, `{
+		"id": 42,
+		"initial": "J",
+		"flags": [1, -1, 255],
+		"birthday": "2000-01-02",
+		"address": {"city": "Istanbul"},
+		"previous-addresses": [{"city": "Ankara"}]
+	}`)
+	require.NoError(t, err)
+	p := v.(serialization.VersionedPortable)
+	require.Equal(t, int32(1), p.FactoryID())
+	require.Equal(t, int32(1), p.ClassID())
+	require.Equal(t, int32(3), p.Version())
+	fields := v.(*portableValue).fields
+	require.Equal(t, int64(42), fields["id"])
+	require.Equal(t, uint16('J'), fields["initial"])
+	require.Equal(t, []byte{1, 255, 255}, fields["flags"])
+	require.Equal(t, types.LocalDate(time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)), *fields["birthday"].(*types.LocalDate))
+	require.Equal(t, "Istanbul", fields["address"].(*portableValue).fields["city"])
+	prev := fields["previous-addresses"].([]serialization.Portable)
+	require.Len(t, prev, 1)
+	require.Equal(t, "Ankara", prev[0].(*portableValue).fields["city"])
+}
+
+func TestNewPortableValue_Error(t *testing.T) {
+	classes, err := LoadPortableClasses("testdata/portable_classes.yaml")
+	require.NoError(t, err)
+	_, err = RegisterPortableClasses(classes)
+	require.NoError(t, err)
+	testCases := []struct {
+		name    string
+		classID int32
+		text    string
+		errText string
+	}{
+		{name: "unknown class", classID: 5, text: `{}`, errText: "unknown Portable class: 1:5"},
+		{name: "unknown field", classID: 1, text: `{"foo": 1}`, errText: "unknown field: foo"},
+		{name: "char", classID: 1, text: `{"initial": "ab"}`, errText: "1:1.initial: expected a single character"},
+		{name: "byte", classID: 1, text: `{"flags": [256]}`, errText: "item 0: expected a byte"},
+		{name: "null portable array item", classID: 1, text: `{"previous-addresses": [null]}`, errText: "item 0: null is not allowed"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewPortableValue(1, tc.classID, tc.text)
+			require.ErrorContains(t, err, tc.errText)
+		})
+	}
+}
+
+func TestRegisterPortableClasses_UnknownNestedClass(t *testing.T) {
+	_, err := RegisterPortableClasses([]PortableClass{{
+		FactoryID: 1,
+		ClassID:   1,
+		Fields:    []PortableFieldSchema{{Name: "foo", Type: "PORTABLE", FactoryID: 1, ClassID: 2}},
+	}})
+	require.ErrorContains(t, err, "unknown Portable class for field foo: 1:2")
+}
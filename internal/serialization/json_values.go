@@ -0,0 +1,237 @@
+package serialization
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// jsonConverter converts a value decoded from JSON to the value of a field.
+// The numbers are expected to be decoded as json.Number.
+type jsonConverter func(v any) (any, error)
+
+func scalar[T any](f func(v any) (T, error)) jsonConverter {
+	return func(v any) (any, error) {
+		return f(v)
+	}
+}
+
+func nullable[T any](f func(v any) (T, error)) jsonConverter {
+	return func(v any) (any, error) {
+		t, err := f(v)
+		if err != nil {
+			return nil, err
+		}
+		return &t, nil
+	}
+}
+
+func array[T any](f func(v any) (T, error)) jsonConverter {
+	return func(v any) (any, error) {
+		vs, err := jsonArray(v)
+		if err != nil {
+			return nil, err
+		}
+		r := make([]T, len(vs))
+		for i, item := range vs {
+			if r[i], err = f(item); err != nil {
+				return nil, fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		return r, nil
+	}
+}
+
+func nullableArray[T any](f func(v any) (T, error)) jsonConverter {
+	return func(v any) (any, error) {
+		vs, err := jsonArray(v)
+		if err != nil {
+			return nil, err
+		}
+		r := make([]*T, len(vs))
+		for i, item := range vs {
+			if item == nil {
+				continue
+			}
+			t, err := f(item)
+			if err != nil {
+				return nil, fmt.Errorf("item %d: %w", i, err)
+			}
+			r[i] = &t
+		}
+		return r, nil
+	}
+}
+
+// jsonObjects converts the items of a JSON array using the given function.
+// If allowNull is true, null items are kept as nil.
+func jsonObjects(v any, allowNull bool, f func(item any) (any, error)) ([]any, error) {
+	vs, err := jsonArray(v)
+	if err != nil {
+		return nil, err
+	}
+	r := make([]any, len(vs))
+	for i, item := range vs {
+		if item == nil {
+			if allowNull {
+				continue
+			}
+			return nil, fmt.Errorf("item %d: null is not allowed", i)
+		}
+		if r[i], err = f(item); err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+	return r, nil
+}
+
+func jsonArray(v any) ([]any, error) {
+	vs, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an array, but got: %v", v)
+	}
+	return vs, nil
+}
+
+func jsonBool(v any) (bool, error) {
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expected a boolean, but got: %v", v)
+	}
+	return b, nil
+}
+
+func jsonString(v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("expected a string, but got: %v", v)
+	}
+	return s, nil
+}
+
+func jsonNumber(v any) (string, error) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return "", fmt.Errorf("expected a number, but got: %v", v)
+	}
+	return n.String(), nil
+}
+
+func jsonInt(v any, bitSize int) (int64, error) {
+	s, err := jsonNumber(v)
+	if err != nil {
+		return 0, err
+	}
+	i, err := strconv.ParseInt(s, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("expected a %d bit integer, but got: %s", bitSize, s)
+	}
+	return i, nil
+}
+
+// jsonByte accepts both signed and unsigned bytes, since Java bytes are signed.
+func jsonByte(v any) (byte, error) {
+	i, err := jsonInt(v, 16)
+	if err != nil {
+		return 0, err
+	}
+	if i < math.MinInt8 || i > math.MaxUint8 {
+		return 0, fmt.Errorf("expected a byte, but got: %d", i)
+	}
+	return byte(i), nil
+}
+
+func jsonChar(v any) (uint16, error) {
+	s, err := jsonString(v)
+	if err != nil {
+		return 0, err
+	}
+	return ParseChar(s)
+}
+
+func jsonInt8(v any) (int8, error) {
+	i, err := jsonInt(v, 8)
+	return int8(i), err
+}
+
+func jsonInt16(v any) (int16, error) {
+	i, err := jsonInt(v, 16)
+	return int16(i), err
+}
+
+func jsonInt32(v any) (int32, error) {
+	i, err := jsonInt(v, 32)
+	return int32(i), err
+}
+
+func jsonInt64(v any) (int64, error) {
+	return jsonInt(v, 64)
+}
+
+func jsonFloat(v any, bitSize int) (float64, error) {
+	s, err := jsonNumber(v)
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("expected a %d bit float, but got: %s", bitSize, s)
+	}
+	return f, nil
+}
+
+func jsonFloat32(v any) (float32, error) {
+	f, err := jsonFloat(v, 32)
+	return float32(f), err
+}
+
+func jsonFloat64(v any) (float64, error) {
+	return jsonFloat(v, 64)
+}
+
+func jsonDecimal(v any) (types.Decimal, error) {
+	// decimals may be given as strings in order not to lose precision
+	if s, ok := v.(string); ok {
+		return ParseDecimal(s)
+	}
+	s, err := jsonNumber(v)
+	if err != nil {
+		return types.Decimal{}, err
+	}
+	return ParseDecimal(s)
+}
+
+func jsonTime(v any) (types.LocalTime, error) {
+	s, err := jsonString(v)
+	if err != nil {
+		return types.LocalTime{}, err
+	}
+	return ParseLocalTime(s)
+}
+
+func jsonDate(v any) (types.LocalDate, error) {
+	s, err := jsonString(v)
+	if err != nil {
+		return types.LocalDate{}, err
+	}
+	return ParseLocalDate(s)
+}
+
+func jsonTimestamp(v any) (types.LocalDateTime, error) {
+	s, err := jsonString(v)
+	if err != nil {
+		return types.LocalDateTime{}, err
+	}
+	return ParseLocalDateTime(s)
+}
+
+func jsonTimestampWithTimezone(v any) (types.OffsetDateTime, error) {
+	s, err := jsonString(v)
+	if err != nil {
+		return types.OffsetDateTime{}, err
+	}
+	return ParseOffsetDateTime(s)
+}
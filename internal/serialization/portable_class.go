@@ -0,0 +1,119 @@
+package serialization
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"gopkg.in/yaml.v3"
+)
+
+// PortableClass is the user provided definition of a Portable class.
+type PortableClass struct {
+	FactoryID int32                 `yaml:"factory-id"`
+	ClassID   int32                 `yaml:"class-id"`
+	Version   int32                 `yaml:"version"`
+	Fields    []PortableFieldSchema `yaml:"fields"`
+}
+
+// PortableFieldSchema is the definition of a field of a Portable class.
+// FactoryID and ClassID are required only for the PORTABLE and PORTABLE_ARRAY fields, and they identify the class of the nested value.
+type PortableFieldSchema struct {
+	Name      string `yaml:"name"`
+	Type      string `yaml:"type"`
+	FactoryID int32  `yaml:"factory-id"`
+	ClassID   int32  `yaml:"class-id"`
+}
+
+type portableClassFile struct {
+	Classes []PortableClass `yaml:"classes"`
+}
+
+// LoadPortableClasses loads the Portable class definitions in the given YAML or JSON file.
+func LoadPortableClasses(path string) ([]PortableClass, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f portableClassFile
+	if err := yaml.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("loading Portable classes from %s: %w", path, err)
+	}
+	for _, c := range f.Classes {
+		if err := c.validate(); err != nil {
+			return nil, fmt.Errorf("loading Portable classes from %s: %w", path, err)
+		}
+	}
+	return f.Classes, nil
+}
+
+func (c PortableClass) String() string {
+	return fmt.Sprintf("%d:%d", c.FactoryID, c.ClassID)
+}
+
+func (c PortableClass) validate() error {
+	if c.ClassID == 0 {
+		return fmt.Errorf("%s: class ID cannot be zero", c)
+	}
+	names := make(map[string]struct{}, len(c.Fields))
+	for _, f := range c.Fields {
+		if f.Name == "" {
+			return fmt.Errorf("%s: field name is required", c)
+		}
+		if _, ok := names[f.Name]; ok {
+			return fmt.Errorf("%s: duplicate field: %s", c, f.Name)
+		}
+		names[f.Name] = struct{}{}
+		t, ok := PortableFieldType(f.Type)
+		if !ok {
+			return fmt.Errorf("%s: unknown type for field %s: %s", c, f.Name, f.Type)
+		}
+		if (t == serialization.TypePortable || t == serialization.TypePortableArray) && f.ClassID == 0 {
+			return fmt.Errorf("%s: class ID is required for field %s", c, f.Name)
+		}
+	}
+	return nil
+}
+
+// PortableFieldType returns the field type with the given name.
+// The names are the same with the ones in com.hazelcast.nio.serialization.FieldType, and they are case-insensitive.
+func PortableFieldType(name string) (serialization.FieldDefinitionType, bool) {
+	t, ok := portableFieldTypes[strings.ToUpper(name)]
+	return t, ok
+}
+
+var portableFieldTypes = map[string]serialization.FieldDefinitionType{
+	"PORTABLE":                      serialization.TypePortable,
+	"BYTE":                          serialization.TypeByte,
+	"BOOLEAN":                       serialization.TypeBool,
+	"CHAR":                          serialization.TypeUint16,
+	"SHORT":                         serialization.TypeInt16,
+	"INT":                           serialization.TypeInt32,
+	"LONG":                          serialization.TypeInt64,
+	"FLOAT":                         serialization.TypeFloat32,
+	"DOUBLE":                        serialization.TypeFloat64,
+	"UTF":                           serialization.TypeString,
+	"STRING":                        serialization.TypeString,
+	"PORTABLE_ARRAY":                serialization.TypePortableArray,
+	"BYTE_ARRAY":                    serialization.TypeByteArray,
+	"BOOLEAN_ARRAY":                 serialization.TypeBoolArray,
+	"CHAR_ARRAY":                    serialization.TypeUInt16Array,
+	"SHORT_ARRAY":                   serialization.TypeInt16Array,
+	"INT_ARRAY":                     serialization.TypeInt32Array,
+	"LONG_ARRAY":                    serialization.TypeInt64Array,
+	"FLOAT_ARRAY":                   serialization.TypeFloat32Array,
+	"DOUBLE_ARRAY":                  serialization.TypeFloat64Array,
+	"UTF_ARRAY":                     serialization.TypeStringArray,
+	"STRING_ARRAY":                  serialization.TypeStringArray,
+	"DECIMAL":                       serialization.TypeDecimal,
+	"DECIMAL_ARRAY":                 serialization.TypeDecimalArray,
+	"TIME":                          serialization.TypeTime,
+	"TIME_ARRAY":                    serialization.TypeTimeArray,
+	"DATE":                          serialization.TypeDate,
+	"DATE_ARRAY":                    serialization.TypeDateArray,
+	"TIMESTAMP":                     serialization.TypeTimestamp,
+	"TIMESTAMP_ARRAY":               serialization.TypeTimestampArray,
+	"TIMESTAMP_WITH_TIMEZONE":       serialization.TypeTimestampWithTimezone,
+	"TIMESTAMP_WITH_TIMEZONE_ARRAY": serialization.TypeTimestampWithTimezoneArray,
+}
@@ -0,0 +1,39 @@
+package serialization
+
+// The Rabin fingerprint implementation below is the same with the one Hazelcast uses to compute the Compact schema IDs.
+
+const rabinFingerprintInit int64 = -4513414715797952619
+
+var rabinFingerprintTable = makeRabinFingerprintTable()
+
+func makeRabinFingerprintTable() [256]int64 {
+	var table [256]int64
+	for i := int64(0); i < 256; i++ {
+		fp := i
+		for j := 0; j < 8; j++ {
+			fp = int64(uint64(fp)>>1) ^ (rabinFingerprintInit & -(fp & 1))
+		}
+		table[i] = fp
+	}
+	return table
+}
+
+func rabinFingerprintOfString(fp int64, s string) int64 {
+	b := []byte(s)
+	fp = rabinFingerprintOfInt32(fp, int32(len(b)))
+	for _, c := range b {
+		fp = rabinFingerprintOfByte(fp, c)
+	}
+	return fp
+}
+
+func rabinFingerprintOfInt32(fp int64, v int32) int64 {
+	fp = rabinFingerprintOfByte(fp, byte(v))
+	fp = rabinFingerprintOfByte(fp, byte(v>>8))
+	fp = rabinFingerprintOfByte(fp, byte(v>>16))
+	return rabinFingerprintOfByte(fp, byte(v>>24))
+}
+
+func rabinFingerprintOfByte(fp int64, b byte) int64 {
+	return int64(uint64(fp)>>8) ^ rabinFingerprintTable[(fp^int64(b))&0xff]
+}
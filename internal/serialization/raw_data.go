@@ -0,0 +1,426 @@
+package serialization
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	javaStreamMagic           = 0xACED
+	javaTCNull                = 0x70
+	javaTCReference           = 0x71
+	javaTCClassDesc           = 0x72
+	javaTCObject              = 0x73
+	javaTCString              = 0x74
+	javaTCEndBlockData        = 0x78
+	javaTCLongString          = 0x7C
+	javaTCEnum                = 0x7E
+	javaBaseWireHandle        = 0x7E0000
+	compactSchemaIDSize       = 8
+	portableClassHeaderSize   = 8
+	identifiedDataHeaderSize  = 8
+	maxRawDataTextPayloadSize = 64
+)
+
+// RawData is the serialization information of a value, which can be inspected without decoding it.
+type RawData struct {
+	TypeID        int32
+	PartitionHash int32
+	Payload       []byte
+	// FactoryID and ClassID are set for IdentifiedDataSerializable and Portable values.
+	FactoryID *int32
+	ClassID   *int32
+	// SchemaID is set for Compact values.
+	SchemaID *int64
+	// ClassName is set for DataSerializable values which are not identified, and Java serialized values.
+	ClassName string
+	// JavaFields are the fields of the top level class of Java serialized values.
+	JavaFields []JavaField
+	// EnumConstant is set for Java serialized enum values.
+	EnumConstant string
+}
+
+// JavaField is a field declared in a Java serialization stream.
+type JavaField struct {
+	Name string
+	Type string
+}
+
+// InspectData returns the serialization information of the given serialized value.
+// The details which cannot be found in the payload are left blank.
+func InspectData(data []byte) (RawData, error) {
+	var rd RawData
+	if len(data) < DataHeaderSize {
+		return rd, errors.New("invalid data: data is too short")
+	}
+	rd.PartitionHash = int32(binary.BigEndian.Uint32(data))
+	rd.TypeID = int32(binary.BigEndian.Uint32(data[dataTypeOffset:]))
+	rd.Payload = data[DataHeaderSize:]
+	r := &byteReader{b: rd.Payload}
+	switch rd.TypeID {
+	case TypeDataSerializable:
+		rd.inspectDataSerializable(r)
+	case TypePortable:
+		if len(rd.Payload) >= portableClassHeaderSize {
+			fid, _ := r.readInt32()
+			cid, _ := r.readInt32()
+			rd.FactoryID, rd.ClassID = &fid, &cid
+		}
+	case TypeCompact:
+		if len(rd.Payload) >= compactSchemaIDSize {
+			id := int64(binary.BigEndian.Uint64(rd.Payload))
+			rd.SchemaID = &id
+		}
+	case TypeJavaDefaultTypeSerializable:
+		rd.inspectJavaObject(r)
+	case TypeJavaDefaultTypeExternalizable:
+		// the class name is written before the Java serialization stream
+		if s, err := r.readString(); err == nil {
+			rd.ClassName = s
+		}
+	}
+	return rd, nil
+}
+
+// NondecodedValue returns the value to output for the given data which could not be decoded.
+// If raw is true, the serialization information of the data is returned.
+// Otherwise, the label of the serialization type is returned.
+func NondecodedValue(data []byte, raw bool) any {
+	if raw {
+		if rd, err := InspectData(data); err == nil {
+			return rd
+		}
+	}
+	t := int32(TypeNil)
+	if len(data) >= DataHeaderSize {
+		t = int32(binary.BigEndian.Uint32(data[dataTypeOffset:]))
+	}
+	return NondecodedType(TypeToLabel(t))
+}
+
+func (rd *RawData) inspectDataSerializable(r *byteReader) {
+	header, err := r.readByte()
+	if err != nil {
+		return
+	}
+	if header&idsFlagIdentified == 0 {
+		if s, err := r.readString(); err == nil {
+			rd.ClassName = s
+		}
+		return
+	}
+	if len(r.b)-r.pos < identifiedDataHeaderSize {
+		return
+	}
+	fid, _ := r.readInt32()
+	cid, _ := r.readInt32()
+	rd.FactoryID, rd.ClassID = &fid, &cid
+}
+
+// inspectJavaObject finds the class name and the fields of the top level object in the Java serialization stream.
+// See: https://docs.oracle.com/javase/8/docs/platform/serialization/spec/protocol.html
+func (rd *RawData) inspectJavaObject(r *byteReader) {
+	jr := javaStreamReader{r: r}
+	magic, err := r.readUint16()
+	if err != nil || magic != javaStreamMagic {
+		return
+	}
+	// skip the stream version
+	if err := r.skip(2); err != nil {
+		return
+	}
+	tc, err := r.readByte()
+	if err != nil {
+		return
+	}
+	switch tc {
+	case javaTCObject:
+		cd, err := jr.readClassDesc()
+		if err != nil || cd == nil {
+			return
+		}
+		rd.ClassName = cd.name
+		rd.JavaFields = cd.fields
+	case javaTCEnum:
+		cd, err := jr.readClassDesc()
+		if err != nil || cd == nil {
+			return
+		}
+		rd.ClassName = cd.name
+		// the handle of the enum constant
+		jr.newHandle(nil)
+		if s, err := jr.readStringObject(); err == nil {
+			rd.EnumConstant = s
+		}
+	}
+}
+
+func (rd RawData) TypeLabel() string {
+	return TypeToLabel(rd.TypeID)
+}
+
+func (rd RawData) Hex() string {
+	return hex.EncodeToString(rd.Payload)
+}
+
+func (rd RawData) Base64() string {
+	return base64.StdEncoding.EncodeToString(rd.Payload)
+}
+
+// JavaFieldsText returns the fields of the Java serialized object in the "type name" format.
+func (rd RawData) JavaFieldsText() string {
+	fs := make([]string, len(rd.JavaFields))
+	for i, f := range rd.JavaFields {
+		fs[i] = fmt.Sprintf("%s %s", f.Type, f.Name)
+	}
+	return strings.Join(fs, ", ")
+}
+
+func (rd RawData) Text() string {
+	cs := ColumnMap{
+		{Name: "type", Type: TypeString, Value: fmt.Sprintf("%s(%d)", rd.TypeLabel(), rd.TypeID)},
+		{Name: "partitionHash", Type: TypeInt32, Value: rd.PartitionHash},
+	}
+	cs = append(cs, rd.detailColumns()...)
+	if len(rd.JavaFields) > 0 {
+		cs = append(cs, Column{Name: "fields", Type: TypeString, Value: rd.JavaFieldsText()})
+	}
+	payload := rd.Hex()
+	if len(rd.Payload) > maxRawDataTextPayloadSize {
+		payload = hex.EncodeToString(rd.Payload[:maxRawDataTextPayloadSize]) + "..."
+	}
+	cs = append(cs, Column{Name: "payload", Type: TypeString, Value: "0x" + payload})
+	return cs.Text()
+}
+
+func (rd RawData) JSONValue() (any, error) {
+	m := map[string]any{
+		"typeId":        rd.TypeID,
+		"type":          rd.TypeLabel(),
+		"partitionHash": rd.PartitionHash,
+		"hex":           rd.Hex(),
+		"base64":        rd.Base64(),
+	}
+	for _, c := range rd.detailColumns() {
+		m[c.Name] = c.Value
+	}
+	if len(rd.JavaFields) > 0 {
+		fs := make([]map[string]any, len(rd.JavaFields))
+		for i, f := range rd.JavaFields {
+			fs[i] = map[string]any{"name": f.Name, "type": f.Type}
+		}
+		m["javaFields"] = fs
+	}
+	return m, nil
+}
+
+func (rd RawData) detailColumns() []Column {
+	var cs []Column
+	if rd.FactoryID != nil {
+		cs = append(cs, Column{Name: "factoryId", Type: TypeInt32, Value: *rd.FactoryID})
+	}
+	if rd.ClassID != nil {
+		cs = append(cs, Column{Name: "classId", Type: TypeInt32, Value: *rd.ClassID})
+	}
+	if rd.SchemaID != nil {
+		cs = append(cs, Column{Name: "schemaId", Type: TypeInt64, Value: *rd.SchemaID})
+	}
+	if rd.ClassName != "" {
+		cs = append(cs, Column{Name: "className", Type: TypeString, Value: rd.ClassName})
+	}
+	if rd.EnumConstant != "" {
+		cs = append(cs, Column{Name: "enumConstant", Type: TypeString, Value: rd.EnumConstant})
+	}
+	return cs
+}
+
+type javaClassDesc struct {
+	name   string
+	fields []JavaField
+}
+
+// javaStreamReader reads the class descriptors in a Java serialization stream.
+type javaStreamReader struct {
+	r *byteReader
+	// handles keeps the objects which can be referred to later in the stream.
+	// Only class descriptors and strings are kept, the other objects are nil.
+	handles []any
+}
+
+func (jr *javaStreamReader) newHandle(v any) {
+	jr.handles = append(jr.handles, v)
+}
+
+func (jr *javaStreamReader) handle(h int32) (any, error) {
+	i := int(h) - javaBaseWireHandle
+	if i < 0 || i >= len(jr.handles) {
+		return nil, fmt.Errorf("invalid handle: %d", h)
+	}
+	return jr.handles[i], nil
+}
+
+// readClassDesc reads a class descriptor, including its super class descriptors.
+// Returns nil if the class descriptor is null.
+func (jr *javaStreamReader) readClassDesc() (*javaClassDesc, error) {
+	tc, err := jr.r.readByte()
+	if err != nil {
+		return nil, err
+	}
+	switch tc {
+	case javaTCNull:
+		return nil, nil
+	case javaTCReference:
+		h, err := jr.r.readInt32()
+		if err != nil {
+			return nil, err
+		}
+		v, err := jr.handle(h)
+		if err != nil {
+			return nil, err
+		}
+		cd, ok := v.(*javaClassDesc)
+		if !ok {
+			return nil, fmt.Errorf("handle is not a class descriptor: %d", h)
+		}
+		return cd, nil
+	case javaTCClassDesc:
+		name, err := jr.r.readUTF()
+		if err != nil {
+			return nil, err
+		}
+		// skip serialVersionUID
+		if err := jr.r.skip(8); err != nil {
+			return nil, err
+		}
+		cd := &javaClassDesc{name: name}
+		jr.newHandle(cd)
+		// skip the flags
+		if err := jr.r.skip(1); err != nil {
+			return nil, err
+		}
+		n, err := jr.r.readUint16()
+		if err != nil {
+			return nil, err
+		}
+		cd.fields = make([]JavaField, n)
+		for i := range cd.fields {
+			if cd.fields[i], err = jr.readFieldDesc(); err != nil {
+				return nil, err
+			}
+		}
+		// only empty class annotations are supported
+		if tc, err = jr.r.readByte(); err != nil {
+			return nil, err
+		}
+		if tc != javaTCEndBlockData {
+			return cd, errors.New("class annotations are not supported")
+		}
+		// the super class descriptor is read only to move past it
+		if _, err := jr.readClassDesc(); err != nil {
+			return cd, err
+		}
+		return cd, nil
+	}
+	return nil, fmt.Errorf("unsupported class descriptor: 0x%02x", tc)
+}
+
+func (jr *javaStreamReader) readFieldDesc() (JavaField, error) {
+	var f JavaField
+	code, err := jr.r.readByte()
+	if err != nil {
+		return f, err
+	}
+	if f.Name, err = jr.r.readUTF(); err != nil {
+		return f, err
+	}
+	if code != '[' && code != 'L' {
+		f.Type = javaTypeName(string(code))
+		return f, nil
+	}
+	sig, err := jr.readStringObject()
+	if err != nil {
+		return f, err
+	}
+	f.Type = javaTypeName(sig)
+	return f, nil
+}
+
+func (jr *javaStreamReader) readStringObject() (string, error) {
+	tc, err := jr.r.readByte()
+	if err != nil {
+		return "", err
+	}
+	switch tc {
+	case javaTCString:
+		s, err := jr.r.readUTF()
+		if err != nil {
+			return "", err
+		}
+		jr.newHandle(s)
+		return s, nil
+	case javaTCLongString:
+		if err := jr.r.skip(4); err != nil {
+			return "", err
+		}
+		n, err := jr.r.readInt32()
+		if err != nil {
+			return "", err
+		}
+		b, err := jr.r.readBytes(int(n))
+		if err != nil {
+			return "", err
+		}
+		jr.newHandle(string(b))
+		return string(b), nil
+	case javaTCReference:
+		h, err := jr.r.readInt32()
+		if err != nil {
+			return "", err
+		}
+		v, err := jr.handle(h)
+		if err != nil {
+			return "", err
+		}
+		s, ok := v.(string)
+		if !ok {
+			return "", fmt.Errorf("handle is not a string: %d", h)
+		}
+		return s, nil
+	}
+	return "", fmt.Errorf("unsupported string: 0x%02x", tc)
+}
+
+// javaTypeName returns the Java type name for the given field type signature.
+func javaTypeName(sig string) string {
+	dims := 0
+	for strings.HasPrefix(sig, "[") {
+		dims++
+		sig = sig[1:]
+	}
+	var name string
+	switch sig {
+	case "B":
+		name = "byte"
+	case "C":
+		name = "char"
+	case "D":
+		name = "double"
+	case "F":
+		name = "float"
+	case "I":
+		name = "int"
+	case "J":
+		name = "long"
+	case "S":
+		name = "short"
+	case "Z":
+		name = "boolean"
+	default:
+		name = strings.ReplaceAll(strings.TrimSuffix(strings.TrimPrefix(sig, "L"), ";"), "/", ".")
+	}
+	return name + strings.Repeat("[]", dims)
+}
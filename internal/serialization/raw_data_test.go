@@ -0,0 +1,154 @@
+package serialization
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspectData(t *testing.T) {
+	testCases := []struct {
+		name    string
+		data    []byte
+		inspect func(t *testing.T, rd RawData)
+	}{
+		{
+			name: "IdentifiedDataSerializable",
+			data: makeData(TypeDataSerializable, byte(idsFlagIdentified), int32(1), int32(2), int32(42)),
+			inspect: func(t *testing.T, rd RawData) {
+				require.Equal(t, int32(1), *rd.FactoryID)
+				require.Equal(t, int32(2), *rd.ClassID)
+			},
+		},
+		{
+			name: "DataSerializable",
+			data: makeData(TypeDataSerializable, byte(0), hzString("com.acme.Foo")),
+			inspect: func(t *testing.T, rd RawData) {
+				require.Nil(t, rd.FactoryID)
+				require.Equal(t, "com.acme.Foo", rd.ClassName)
+			},
+		},
+		{
+			name: "Portable",
+			data: makeData(TypePortable, int32(3), int32(4), int32(0)),
+			inspect: func(t *testing.T, rd RawData) {
+				require.Equal(t, int32(3), *rd.FactoryID)
+				require.Equal(t, int32(4), *rd.ClassID)
+			},
+		},
+		{
+			name: "Compact",
+			data: makeData(TypeCompact, int64(-5)),
+			inspect: func(t *testing.T, rd RawData) {
+				require.Equal(t, int64(-5), *rd.SchemaID)
+			},
+		},
+		{
+			name: "Java object",
+			data: makeData(TypeJavaDefaultTypeSerializable,
+				uint16(javaStreamMagic), uint16(5),
+				byte(javaTCObject), byte(javaTCClassDesc), javaUTF("com.acme.Person"), int64(1), byte(2), uint16(3),
+				byte('I'), javaUTF("age"),
+				byte('L'), javaUTF("name"), byte(javaTCString), javaUTF("Ljava/lang/String;"),
+				byte('L'), javaUTF("nickname"), byte(javaTCReference), int32(javaBaseWireHandle+1),
+				byte(javaTCEndBlockData), byte(javaTCNull),
+				int32(42), byte(javaTCString), javaUTF("Jane"), byte(javaTCNull),
+			),
+			inspect: func(t *testing.T, rd RawData) {
+				require.Equal(t, "com.acme.Person", rd.ClassName)
+				require.Equal(t, []JavaField{
+					{Name: "age", Type: "int"},
+					{Name: "name", Type: "java.lang.String"},
+					{Name: "nickname", Type: "java.lang.String"},
+				}, rd.JavaFields)
+			},
+		},
+		{
+			name: "Java enum",
+			data: makeData(TypeJavaDefaultTypeSerializable,
+				uint16(javaStreamMagic), uint16(5),
+				byte(javaTCEnum), byte(javaTCClassDesc), javaUTF("com.acme.Color"), int64(0), byte(0x12), uint16(0),
+				byte(javaTCEndBlockData),
+				byte(javaTCClassDesc), javaUTF("java.lang.Enum"), int64(0), byte(0x12), uint16(0),
+				byte(javaTCEndBlockData), byte(javaTCNull),
+				byte(javaTCString), javaUTF("RED"),
+			),
+			inspect: func(t *testing.T, rd RawData) {
+				require.Equal(t, "com.acme.Color", rd.ClassName)
+				require.Equal(t, "RED", rd.EnumConstant)
+			},
+		},
+		{
+			name: "Java externalizable",
+			data: makeData(TypeJavaDefaultTypeExternalizable, hzString("com.acme.Ext"), uint16(javaStreamMagic)),
+			inspect: func(t *testing.T, rd RawData) {
+				require.Equal(t, "com.acme.Ext", rd.ClassName)
+			},
+		},
+		{
+			name: "truncated",
+			data: makeData(TypeDataSerializable, byte(idsFlagIdentified), int32(1)),
+			inspect: func(t *testing.T, rd RawData) {
+				require.Nil(t, rd.FactoryID)
+				require.Equal(t, []byte{1, 0, 0, 0, 1}, rd.Payload)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rd, err := InspectData(tc.data)
+			require.NoError(t, err)
+			require.Equal(t, int32(7), rd.PartitionHash)
+			require.Equal(t, tc.data[DataHeaderSize:], rd.Payload)
+			tc.inspect(t, rd)
+		})
+	}
+}
+
+func TestInspectData_Error(t *testing.T) {
+	_, err := InspectData([]byte{1, 2, 3})
+	require.Error(t, err)
+}
+
+func TestRawData_Text(t *testing.T) {
+	rd, err := InspectData(makeData(TypeDataSerializable, byte(idsFlagIdentified), int32(1), int32(2)))
+	require.NoError(t, err)
+	require.Equal(t, "{type:DATA_SERIALIZABLE(-2); partitionHash:7; factoryId:1; classId:2; payload:0x010000000100000002}", rd.Text())
+}
+
+func TestNondecodedValue(t *testing.T) {
+	data := makeData(TypeDataSerializable, byte(idsFlagIdentified), int32(1), int32(2))
+	require.Equal(t, NondecodedType("DATA_SERIALIZABLE"), NondecodedValue(data, false))
+	require.IsType(t, RawData{}, NondecodedValue(data, true))
+}
+
+type hzString string
+
+type javaUTF string
+
+// makeData creates serialized data with the given type and the payload made of the given values.
+func makeData(typeID int32, values ...any) []byte {
+	var b bytes.Buffer
+	w := func(v any) {
+		if err := binary.Write(&b, binary.BigEndian, v); err != nil {
+			panic(err)
+		}
+	}
+	w(int32(7))
+	w(typeID)
+	for _, v := range values {
+		switch vv := v.(type) {
+		case hzString:
+			w(int32(len(vv)))
+			b.WriteString(string(vv))
+		case javaUTF:
+			w(uint16(len(vv)))
+			b.WriteString(string(vv))
+		default:
+			w(v)
+		}
+	}
+	return b.Bytes()
+}
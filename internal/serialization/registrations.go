@@ -0,0 +1,29 @@
+package serialization
+
+// Registrations are the registered Compact schemas and Portable classes.
+// Creating the configuration of a cluster replaces them, so they are saved before creating the configuration of another cluster and restored afterwards.
+type Registrations struct {
+	compact  compactSerializers
+	portable portableClasses
+}
+
+// SaveRegistrations returns the currently registered Compact schemas and Portable classes.
+func SaveRegistrations() Registrations {
+	compactTypes.mu.RLock()
+	cs := compactTypes.serializers
+	compactTypes.mu.RUnlock()
+	portableTypes.mu.RLock()
+	pcs := portableTypes.classes
+	portableTypes.mu.RUnlock()
+	return Registrations{compact: cs, portable: pcs}
+}
+
+// Restore replaces the registered Compact schemas and Portable classes with the saved ones.
+func (r Registrations) Restore() {
+	compactTypes.mu.Lock()
+	compactTypes.serializers = r.compact
+	compactTypes.mu.Unlock()
+	portableTypes.mu.Lock()
+	portableTypes.classes = r.portable
+	portableTypes.mu.Unlock()
+}
@@ -0,0 +1,27 @@
+package serialization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistrations_Restore(t *testing.T) {
+	schemas, err := LoadCompactSchemas("testdata/compact_schemas.yaml")
+	require.NoError(t, err)
+	_, err = RegisterCompactSchemas(schemas)
+	require.NoError(t, err)
+	saved := SaveRegistrations()
+	_, err = RegisterCompactSchemas([]CompactSchema{{
+		TypeName: "com.acme.Other",
+		Fields:   []CompactFieldSchema{{Name: "name", Kind: "STRING"}},
+	}})
+	require.NoError(t, err)
+	_, err = NewCompactValue("com.acme.User", `{"id": 42}`)
+	require.ErrorContains(t, err, "unknown Compact type: com.acme.User")
+	saved.Restore()
+	_, err = NewCompactValue("com.acme.User", `{"id": 42}`)
+	require.NoError(t, err)
+	_, err = NewCompactValue("com.acme.Other", `{"name": "foo"}`)
+	require.ErrorContains(t, err, "unknown Compact type: com.acme.Other")
+}
@@ -0,0 +1,120 @@
+package serialization
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	layoutDate     = "2006-01-02"
+	layoutTime     = "15:04:05"
+	layoutDateTime = "2006-01-02T15:04:05"
+)
+
+// ParseDecimal parses a decimal number, such as 12.345 or -1.2e-3, without losing precision.
+func ParseDecimal(s string) (types.Decimal, error) {
+	orig := s
+	s = strings.TrimSpace(s)
+	var exp int64
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		exp, err = strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return types.Decimal{}, fmt.Errorf("invalid decimal: %s", orig)
+		}
+		s = s[:i]
+	}
+	var neg bool
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return types.Decimal{}, fmt.Errorf("invalid decimal: %s", orig)
+	}
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return types.Decimal{}, fmt.Errorf("invalid decimal: %s", orig)
+	}
+	scale := int64(len(fracPart)) - exp
+	if scale < 0 {
+		unscaled.Mul(unscaled, new(big.Int).Exp(big.NewInt(10), big.NewInt(-scale), nil))
+		scale = 0
+	}
+	if neg {
+		unscaled.Neg(unscaled)
+	}
+	return types.NewDecimal(unscaled, int(scale)), nil
+}
+
+// ParseUUID parses a UUID in the canonical 8-4-4-4-12 hex digits format.
+func ParseUUID(s string) (types.UUID, error) {
+	parts := strings.Split(s, "-")
+	h := strings.Join(parts, "")
+	if len(parts) != 5 || len(parts[0]) != 8 || len(parts[1]) != 4 || len(parts[2]) != 4 || len(parts[3]) != 4 || len(h) != 32 {
+		return types.UUID{}, fmt.Errorf("invalid UUID: %s", s)
+	}
+	msb, err := strconv.ParseUint(h[:16], 16, 64)
+	if err != nil {
+		return types.UUID{}, fmt.Errorf("invalid UUID: %s", s)
+	}
+	lsb, err := strconv.ParseUint(h[16:], 16, 64)
+	if err != nil {
+		return types.UUID{}, fmt.Errorf("invalid UUID: %s", s)
+	}
+	return types.NewUUIDWith(msb, lsb), nil
+}
+
+// ParseChar parses a single character which can be represented with a Java char.
+func ParseChar(s string) (uint16, error) {
+	rs := []rune(s)
+	if len(rs) != 1 || rs[0] > math.MaxUint16 {
+		return 0, fmt.Errorf("expected a single character, but got: %s", s)
+	}
+	return uint16(rs[0]), nil
+}
+
+// ParseLocalDate parses a date in the YYYY-MM-DD format.
+func ParseLocalDate(s string) (types.LocalDate, error) {
+	t, err := time.Parse(layoutDate, s)
+	if err != nil {
+		return types.LocalDate{}, fmt.Errorf("invalid date: %s (should be in YYYY-MM-DD format)", s)
+	}
+	return types.LocalDate(t), nil
+}
+
+// ParseLocalTime parses a time in the HH:MM:SS format, with optional fractional seconds.
+func ParseLocalTime(s string) (types.LocalTime, error) {
+	t, err := time.Parse(layoutTime, s)
+	if err != nil {
+		return types.LocalTime{}, fmt.Errorf("invalid time: %s (should be in HH:MM:SS format)", s)
+	}
+	return types.LocalTime(t), nil
+}
+
+// ParseLocalDateTime parses a date and time in the YYYY-MM-DDTHH:MM:SS format, with optional fractional seconds.
+// A space can be used instead of T.
+func ParseLocalDateTime(s string) (types.LocalDateTime, error) {
+	t, err := time.Parse(layoutDateTime, strings.Replace(s, " ", "T", 1))
+	if err != nil {
+		return types.LocalDateTime{}, fmt.Errorf("invalid date time: %s (should be in YYYY-MM-DDTHH:MM:SS format)", s)
+	}
+	return types.LocalDateTime(t), nil
+}
+
+// ParseOffsetDateTime parses a date and time with a time zone offset in the RFC 3339 format.
+func ParseOffsetDateTime(s string) (types.OffsetDateTime, error) {
+	t, err := time.Parse(time.RFC3339, strings.Replace(s, " ", "T", 1))
+	if err != nil {
+		return types.OffsetDateTime{}, fmt.Errorf("invalid date time with time zone: %s (should be in RFC 3339 format, e.g., 2006-01-02T15:04:05+07:00)", s)
+	}
+	return types.OffsetDateTime(t), nil
+}
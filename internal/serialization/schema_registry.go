@@ -0,0 +1,166 @@
+package serialization
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/hazelcast/hazelcast-go-client"
+)
+
+const schemaFileExt = ".yaml"
+
+// RegisteredSchema is a Compact schema saved in the local schema registry.
+type RegisteredSchema struct {
+	CompactSchema
+	ID      int64
+	SavedAt time.Time
+}
+
+// SchemaRegistry keeps Compact schemas in a directory.
+// Each schema is saved to a separate file named after its type name and schema ID.
+// The schema files have the same format with the Compact schema files, with a single type.
+type SchemaRegistry struct {
+	dir string
+}
+
+func NewSchemaRegistry(dir string) SchemaRegistry {
+	return SchemaRegistry{dir: dir}
+}
+
+// Dir returns the directory of the registry.
+func (r SchemaRegistry) Dir() string {
+	return r.dir
+}
+
+// Save saves the given schema to the registry.
+// Returns true if the schema did not exist in the registry before.
+func (r SchemaRegistry) Save(s CompactSchema) (bool, error) {
+	if err := s.validate(); err != nil {
+		return false, err
+	}
+	path := filepath.Join(r.dir, schemaFileName(s.TypeName, s.ID()))
+	if _, err := os.Stat(path); err == nil {
+		return false, nil
+	}
+	b, err := MarshalCompactSchemas([]CompactSchema{s})
+	if err != nil {
+		return false, err
+	}
+	if err := os.MkdirAll(r.dir, 0700); err != nil {
+		return false, err
+	}
+	// write to a temporary file first, so a partially written schema file is never seen.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return false, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Schemas returns the schemas in the registry, sorted by the type name and the save time.
+// Returns no schemas if the registry directory does not exist.
+func (r SchemaRegistry) Schemas() ([]RegisteredSchema, error) {
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var rs []RegisteredSchema
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != schemaFileExt {
+			continue
+		}
+		path := filepath.Join(r.dir, e.Name())
+		ss, err := LoadCompactSchemas(path)
+		if err != nil {
+			return nil, err
+		}
+		if len(ss) != 1 {
+			return nil, fmt.Errorf("loading Compact schemas from %s: expected a single type", path)
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, RegisteredSchema{
+			CompactSchema: ss[0],
+			ID:            ss[0].ID(),
+			SavedAt:       info.ModTime(),
+		})
+	}
+	sort.SliceStable(rs, func(i, j int) bool {
+		if rs[i].TypeName != rs[j].TypeName {
+			return rs[i].TypeName < rs[j].TypeName
+		}
+		return rs[i].SavedAt.Before(rs[j].SavedAt)
+	})
+	return rs, nil
+}
+
+// Find returns the schemas of the given type, sorted by the save time.
+func (r SchemaRegistry) Find(typeName string) ([]RegisteredSchema, error) {
+	rs, err := r.Schemas()
+	if err != nil {
+		return nil, err
+	}
+	var found []RegisteredSchema
+	for _, s := range rs {
+		if s.TypeName == typeName {
+			found = append(found, s)
+		}
+	}
+	return found, nil
+}
+
+// Latest returns the most recently saved schema of each type, sorted by the type name.
+func (r SchemaRegistry) Latest() ([]RegisteredSchema, error) {
+	rs, err := r.Schemas()
+	if err != nil {
+		return nil, err
+	}
+	var latest []RegisteredSchema
+	for _, s := range rs {
+		if n := len(latest); n > 0 && latest[n-1].TypeName == s.TypeName {
+			latest[n-1] = s
+			continue
+		}
+		latest = append(latest, s)
+	}
+	return latest, nil
+}
+
+func schemaFileName(typeName string, id int64) string {
+	// type names may contain characters that are not allowed in file names
+	name := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune(".-_$", r)) {
+			return r
+		}
+		return '_'
+	}, typeName)
+	return fmt.Sprintf("%s.%016x%s", name, uint64(id), schemaFileExt)
+}
+
+var cachedSchemaIDs sync.Map
+
+// cacheSchema saves the given schema received from the cluster to the registry at the given directory.
+// Each schema is saved at most once during the lifetime of the process.
+func cacheSchema(dir string, schema *hazelcast.Schema) {
+	if _, loaded := cachedSchemaIDs.LoadOrStore(schema.ID(), struct{}{}); loaded {
+		return
+	}
+	// errors are ignored, since caching the schema is not essential for decoding the value
+	_, _ = NewSchemaRegistry(dir).Save(compactSchemaOf(schema))
+}
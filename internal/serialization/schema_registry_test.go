@@ -0,0 +1,73 @@
+package serialization
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactSchema_ID(t *testing.T) {
+	// the expected ID is computed by Hazelcast for the same schema
+	s := CompactSchema{
+		TypeName: "student",
+		Fields: []CompactFieldSchema{
+			{Name: "name", Kind: "STRING"},
+			{Name: "age", Kind: "INT32"},
+		},
+	}
+	require.Equal(t, int64(5500194539746463554), s.ID())
+}
+
+func TestSchemaRegistry(t *testing.T) {
+	dir := t.TempDir()
+	r := NewSchemaRegistry(filepath.Join(dir, "schemas"))
+	rs, err := r.Schemas()
+	require.NoError(t, err)
+	require.Empty(t, rs)
+	v1 := CompactSchema{
+		TypeName: "com.acme.User",
+		Fields:   []CompactFieldSchema{{Name: "id", Kind: "INT64"}},
+	}
+	v2 := CompactSchema{
+		TypeName: "com.acme.User",
+		Fields:   []CompactFieldSchema{{Name: "id", Kind: "INT64"}, {Name: "address", Kind: "COMPACT"}},
+	}
+	other := CompactSchema{
+		TypeName: "com.acme.Address",
+		Fields:   []CompactFieldSchema{{Name: "city", Kind: "STRING"}},
+	}
+	for i, s := range []CompactSchema{v1, v2, other} {
+		ok, err := r.Save(s)
+		require.NoError(t, err)
+		require.True(t, ok)
+		// make sure the save times are ordered
+		tm := time.Now().Add(time.Duration(i-3) * time.Minute)
+		require.NoError(t, os.Chtimes(filepath.Join(r.Dir(), schemaFileName(s.TypeName, s.ID())), tm, tm))
+	}
+	ok, err := r.Save(v1)
+	require.NoError(t, err)
+	require.False(t, ok)
+	rs, err = r.Schemas()
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+	require.Equal(t, "com.acme.Address", rs[0].TypeName)
+	found, err := r.Find("com.acme.User")
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	require.Equal(t, v1, found[0].CompactSchema)
+	require.Equal(t, v1.ID(), found[0].ID)
+	require.Equal(t, v2, found[1].CompactSchema)
+	latest, err := r.Latest()
+	require.NoError(t, err)
+	require.Len(t, latest, 2)
+	require.Equal(t, other, latest[0].CompactSchema)
+	require.Equal(t, v2, latest[1].CompactSchema)
+}
+
+func TestSchemaFileName(t *testing.T) {
+	require.Equal(t, "com.acme.Outer$Inner.000000000000002a.yaml", schemaFileName("com.acme.Outer$Inner", 42))
+	require.Equal(t, "a_b_c.ffffffffffffffff.yaml", schemaFileName("a/b:c", -1))
+}
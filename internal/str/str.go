@@ -50,6 +50,40 @@ func SpacePaddedIntFormat(maxValue int) string {
 	return fmt.Sprintf("%%%dd", len(strconv.Itoa(maxValue)))
 }
 
+// MatchWildcard returns true if the given string matches the pattern.
+// In the pattern, * matches any sequence of characters and ? matches a single character.
+// An empty pattern matches any string.
+func MatchWildcard(pattern, s string) bool {
+	if pattern == "" {
+		return true
+	}
+	// p and i are the current offsets in the pattern and the string,
+	// star and mark are the offsets of the last star in the pattern and the matching offset in the string
+	p, i, star, mark := 0, 0, -1, 0
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == s[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			star = p
+			mark = i
+			p++
+		case star >= 0:
+			// backtrack: let the last star match one more character
+			p = star + 1
+			mark++
+			i = mark
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
 func Colorize(text string) string {
 	if strings.HasPrefix(text, "OK ") {
 		return fmt.Sprintf("    %s %s", color.GreenString("OK"), text[3:])
@@ -108,3 +108,29 @@ func TestSpacePaddedIntFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchWildcard(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		s       string
+		match   bool
+	}{
+		{pattern: "", s: "anything", match: true},
+		{pattern: "cities", s: "cities", match: true},
+		{pattern: "cities", s: "cities2", match: false},
+		{pattern: "cit*", s: "cities", match: true},
+		{pattern: "*ies", s: "cities", match: true},
+		{pattern: "c*t*s", s: "cities", match: true},
+		{pattern: "c?ties", s: "cities", match: true},
+		{pattern: "c?ties", s: "ctties2", match: false},
+		{pattern: "*", s: "", match: true},
+		{pattern: "a*b", s: "aXbXb", match: true},
+		{pattern: "a*b", s: "aXbX", match: false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("%s %s", tc.pattern, tc.s), func(t *testing.T) {
+			assert.Equal(t, tc.match, str.MatchWildcard(tc.pattern, tc.s))
+		})
+	}
+}